@@ -0,0 +1,98 @@
+// Command migrate manages the schema of the cablemodems database configured by the DATABASE_URL / DB_*
+// environment (see postgres.ConfigFromEnv).
+//
+//	migrate status   list every migration and when it was applied
+//	migrate up       apply every pending migration
+//	migrate down     roll back the latest applied migration
+//	migrate redo     roll back the latest applied migration and apply it again
+//
+// Runs hold a Postgres advisory lock, so migrate can safely run from every instance of a deployment at once.
+//
+// Migration 1 adopts the cablemodems table of databases deployed before migrations existed rather than creating
+// it, and rolling it back drops only the time-series tables: down and redo never drop the inventory.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	"api-project/pkg/db/postgres"
+	"api-project/pkg/migrations"
+
+	"github.com/rs/zerolog/log"
+)
+
+func main() {
+	timeout := flag.Duration("timeout", 10*time.Minute, "give up after this long, including waiting for the lock")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: migrate [-timeout d] status|up|down|redo")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	db, err := postgres.Open(ctx, postgres.ConfigFromEnv())
+	if err != nil {
+		log.Fatal().Err(err).Msg("connect")
+	}
+	defer db.Close()
+	m, err := migrations.New(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("load migrations")
+	}
+
+	switch cmd := flag.Arg(0); cmd {
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			log.Fatal().Err(err).Msg("status")
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		w.Flush()
+	case "up":
+		done, err := m.Up(ctx)
+		for _, mig := range done {
+			log.Info().Int("version", mig.Version).Str("name", mig.Name).Msg("applied")
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msg("up")
+		}
+		if len(done) == 0 {
+			log.Info().Msg("already up to date")
+		}
+	case "down", "redo":
+		run := m.Down
+		if cmd == "redo" {
+			run = m.Redo
+		}
+		mig, err := run(ctx)
+		if err != nil {
+			log.Fatal().Err(err).Msg(cmd)
+		}
+		log.Info().Int("version", mig.Version).Str("name", mig.Name).Msg(cmd + " done")
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
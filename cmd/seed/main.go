@@ -0,0 +1,94 @@
+// Command seed generates a synthetic cable plant: CMTSs of every family (acr/cbr/smi by fqdn, remote PHY by
+// ppod), mac domains, fiber nodes, RPDs, OLT/PON ports and the modems behind them. The same -seed always
+// generates the same plant.
+//
+//	seed -cmts 20 -modems 500 -format csv -o plant.csv
+//	seed -format json -o fixture.json        # a fixture for the APIs' -fixture flag
+//	seed -format postgres                    # upserts into the database configured by DATABASE_URL / DB_*
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"api-project/pkg/cablemodems"
+	"api-project/pkg/db/postgres"
+
+	"github.com/rs/zerolog/log"
+)
+
+func main() {
+	var p plant
+	flag.Int64Var(&p.seed, "seed", 1, "random seed; the same seed generates the same plant")
+	flag.IntVar(&p.cmts, "cmts", 10, "number of CMTSs")
+	flag.IntVar(&p.modems, "modems", 200, "number of modems behind each CMTS")
+	at := flag.String("at", "2026-01-01T00:00:00Z", "RFC 3339 time the plant was last polled")
+	format := flag.String("format", "json", "json (fixture), csv (fixture) or postgres")
+	out := flag.String("o", "-", "output file for json and csv, - for stdout")
+	flag.Parse()
+
+	var err error
+	if p.at, err = time.Parse(time.RFC3339, *at); err != nil {
+		log.Fatal().Err(err).Msg("invalid -at")
+	}
+	modems := p.generate()
+
+	switch *format {
+	case "json", "csv":
+		err = writeFixture(*out, *format, modems)
+	case "postgres":
+		err = upsert(modems)
+	default:
+		err = fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Str("format", *format).Msg("seed")
+	}
+	log.Info().Int("modems", len(modems)).Int("cmts", p.cmts).Int64("seed", p.seed).Str("format", *format).Msg("seeded")
+}
+
+// writeFixture writes modems to path, - for stdout, in a format cablemodems.LoadFixture reads.
+func writeFixture(path, format string, modems []*cablemodems.CableModem) error {
+	w := io.Writer(os.Stdout)
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if format == "csv" {
+		return cablemodems.WriteCSV(w, modems)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(modems)
+}
+
+// pool sends every statement of a seed run to its one pool.
+type pool struct{ db *sql.DB }
+
+func (p pool) Reader(context.Context) *sql.DB { return p.db }
+
+func (p pool) Writer(context.Context) *sql.DB { return p.db }
+
+func upsert(modems []*cablemodems.CableModem) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	db, err := postgres.Open(ctx, postgres.ConfigFromEnv())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	_, err = cablemodems.NewPostgres(pool{db}).Upsert(ctx, modems, nil)
+	return err
+}
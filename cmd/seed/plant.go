@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"api-project/pkg/cablemodems"
+	"api-project/pkg/mac"
+)
+
+// plant sizes a generated cable plant.
+type plant struct {
+	seed int64
+	// cmts is the number of CMTSs, modems the number of modems behind each.
+	cmts, modems int
+	// at is when the plant was last polled; every updated_at lies in the day before it.
+	at time.Time
+}
+
+type vendor struct {
+	name   string
+	ouis   []string
+	models []model
+	swRevs []string
+	bootr  string
+}
+
+type model struct {
+	name   string
+	docsis cablemodems.DocsisVersion
+}
+
+var vendors = []vendor{
+	{"ARRIS", []string{"5c22da", "f0af85", "e8825b"},
+		[]model{{"SB8200", cablemodems.Docsis31}, {"TG3452", cablemodems.Docsis3}, {"TG4482A", cablemodems.Docsis31}},
+		[]string{"9.1.103", "9.1.103AA72", "TG3452_2.14.0"}, "PSPU-Boot(BBU) 1.0.16.22"},
+	{"Technicolor", []string{"a4b1e9", "f85b3b"},
+		[]model{{"CGM4331", cablemodems.Docsis31}, {"CGM4981", cablemodems.Docsis4}, {"TC4400", cablemodems.Docsis31}},
+		[]string{"Prod_23.2_231009", "Prod_23.4_240115"}, "S1TC-3.63.20.104"},
+	{"Cisco", []string{"0019d2", "e448c7"},
+		[]model{{"DPC3848", cablemodems.Docsis3}, {"DPC3941", cablemodems.Docsis3}},
+		[]string{"dpc3800-v303r204318-190828a"}, "2.5.0beta9"},
+	{"Hitron", []string{"bcc810", "74ac5f"},
+		[]model{{"CODA-4582", cablemodems.Docsis31}, {"CODA56", cablemodems.Docsis31}},
+		[]string{"7.2.4.7.1b9", "7.3.5.1.1b2"}, "1.0.2"},
+	{"Sercomm", []string{"a0f3e4"},
+		[]model{{"SCG-4981", cablemodems.Docsis4}},
+		[]string{"1.3.1.5"}, "1.1.0"},
+}
+
+var regions = []string{"den", "atl", "chi", "sea", "phx", "bos", "dal", "sjc"}
+
+// cmtsFamilies are the CMTS kinds a plant mixes. The acr/cbr/smi families are addressed by fqdn alone; every
+// other CMTS is addressed by its ppod, behind remote PHY devices.
+var cmtsFamilies = []struct {
+	prefix, cmtsType string
+}{
+	{"acr", "E6000"},
+	{"cbr", "cBR8"},
+	{"smi", "SMI"},
+	{"", "vCMTS"},
+}
+
+// generate builds the plant. The same seed always yields the same modems, in the same order.
+func (p plant) generate() []*cablemodems.CableModem {
+	rnd := rand.New(rand.NewSource(p.seed))
+	seen := map[string]bool{}
+	var modems []*cablemodems.CableModem
+
+	for c := 0; c < p.cmts; c++ {
+		region := regions[c%len(regions)]
+		family := cmtsFamilies[rnd.Intn(len(cmtsFamilies))]
+		site := fmt.Sprintf("%s%02d", region, c/len(regions)+1)
+		var fqdn, ppod *string
+		if family.prefix != "" {
+			fqdn = str(fmt.Sprintf("%s%02d.%s.example.net", family.prefix, c+1, site))
+		} else {
+			ppod = str(strings.ToUpper(site) + fmt.Sprintf("PPOD%02d", c+1))
+		}
+		// remote PHY sites also run an OLT whose DPoE modems hang off PON ports.
+		hasOlt := ppod != nil && rnd.Intn(2) == 0
+		macDomains, fiberNodes := 2+rnd.Intn(6), 4+rnd.Intn(12)
+
+		for i := 0; i < p.modems; i++ {
+			v := vendors[rnd.Intn(len(vendors))]
+			mdl := v.models[rnd.Intn(len(v.models))]
+			mac := uniqueMac(rnd, v, seen)
+			node := rnd.Intn(fiberNodes)
+			updated := p.at.Add(-time.Duration(rnd.Int63n(int64(24 * time.Hour)))).Truncate(time.Second)
+
+			m := &cablemodems.CableModem{
+				Mac:             mac,
+				CableModemIndex: int32p(int32(i + 1)),
+				ConfigFile:      str(configFile(mdl.docsis, rnd)),
+				Model:           str(mdl.name),
+				FiberNode:       str(fmt.Sprintf("FN-%s-%03d", strings.ToUpper(site), node+1)),
+				FnName:          str(fmt.Sprintf("%s node %d", strings.ToUpper(site), node+1)),
+				DocsisVersion:   &mdl.docsis,
+				Fqdn:            fqdn,
+				Ppod:            ppod,
+				Vendor:          str(v.name),
+				SwRev:           str(v.swRevs[rnd.Intn(len(v.swRevs))]),
+				Bootr:           str(v.bootr),
+				UpdatedAt:       str(updated.UTC().Format(time.RFC3339)),
+				UpdatedAtTs:     int32p(int32(updated.Unix())),
+				IsCpe:           boolp(false),
+				CmtsType:        str(family.cmtsType),
+			}
+			if ppod != nil {
+				m.MacDomain = str(fmt.Sprintf("MD-%d", rnd.Intn(macDomains)+1))
+				m.RpdName = str(fmt.Sprintf("RPD-%s-%d", strings.ToUpper(site), node/2+1))
+			} else {
+				m.MacDomain = str(fmt.Sprintf("Cable%d/0/%d", rnd.Intn(2)+1, rnd.Intn(macDomains)))
+			}
+			if hasOlt && rnd.Intn(5) == 0 {
+				m.MacDomain, m.RpdName = nil, nil
+				m.OltName = str(fmt.Sprintf("OLT-%s-1", strings.ToUpper(site)))
+				m.PonName = str(fmt.Sprintf("PON-1/%d/%d", rnd.Intn(4)+1, rnd.Intn(16)+1))
+			}
+
+			online := rnd.Intn(10) != 0
+			if online {
+				m.State, m.RegState = state(cablemodems.Online), int32p(6) // registrationComplete
+				switch r := rnd.Intn(10); {
+				case r < 6:
+					m.Ipv4 = str(fmt.Sprintf("10.%d.%d.%d", c%256, i/250%256, i%250+2))
+				case r < 9:
+					m.Ipv4 = str(fmt.Sprintf("10.%d.%d.%d", c%256, i/250%256, i%250+2))
+					m.Ipv6 = str(fmt.Sprintf("2001:db8:%x:%x::%x", c, i/65536, i%65536+1))
+				default:
+					m.Ipv6 = str(fmt.Sprintf("2001:db8:%x:%x::%x", c, i/65536, i%65536+1))
+				}
+			} else {
+				m.State, m.RegState = state(cablemodems.Offline), int32p(1) // other
+				m.Ipv4 = str("0.0.0.0")
+			}
+			if rnd.Intn(10) < 3 {
+				m.CpeMac = str(uniqueMac(rnd, vendors[rnd.Intn(len(vendors))], seen))
+				if online {
+					m.CpeIpv4 = str(fmt.Sprintf("100.%d.%d.%d", 64+c%64, i/250%256, i%250+2))
+				}
+			}
+			if rnd.Intn(20) == 0 {
+				m.Transponder = str(fmt.Sprintf("HMS-%s-%04d", strings.ToUpper(site), rnd.Intn(10000)))
+				m.NumberOfGenerators = int32p(int32(rnd.Intn(3)))
+			}
+			if rnd.Intn(50) == 0 {
+				m.NotFoundDate = str(p.at.AddDate(0, 0, -rnd.Intn(90)).Format("20060102"))
+			}
+			modems = append(modems, m)
+		}
+	}
+	return modems
+}
+
+// uniqueMac draws a mac in one of the vendor's OUIs that seen does not hold yet, and adds it, in the canonical
+// form of package mac.
+func uniqueMac(rnd *rand.Rand, v vendor, seen map[string]bool) string {
+	for {
+		a, err := mac.Parse(fmt.Sprintf("%s%06x", v.ouis[rnd.Intn(len(v.ouis))], rnd.Intn(1<<24)))
+		if err != nil {
+			panic(err)
+		}
+		if s := a.String(); !seen[s] {
+			seen[s] = true
+			return s
+		}
+	}
+}
+
+func configFile(docsis cablemodems.DocsisVersion, rnd *rand.Rand) string {
+	tiers := []string{"300m", "500m", "1g"}
+	if docsis != cablemodems.Docsis3 {
+		tiers = append(tiers, "2g")
+	}
+	return fmt.Sprintf("%s-%s.cfg", strings.ToLower(string(docsis)), tiers[rnd.Intn(len(tiers))])
+}
+
+func str(s string) *string { return &s }
+
+func int32p(n int32) *int32 { return &n }
+
+func boolp(b bool) *bool { return &b }
+
+func state(s cablemodems.State) *cablemodems.State { return &s }
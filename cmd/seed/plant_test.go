@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"api-project/pkg/cablemodems"
+	"api-project/pkg/mac"
+)
+
+var testPlant = plant{seed: 7, cmts: 12, modems: 50, at: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	t.Parallel()
+	a, b := testPlant.generate(), testPlant.generate()
+	if !reflect.DeepEqual(a, b) {
+		t.Error("the same seed generated different plants")
+	}
+	other := testPlant
+	other.seed++
+	if reflect.DeepEqual(a, other.generate()) {
+		t.Error("different seeds generated the same plant")
+	}
+}
+
+func TestGeneratedPlant(t *testing.T) {
+	t.Parallel()
+	modems := testPlant.generate()
+	if len(modems) != testPlant.cmts*testPlant.modems {
+		t.Fatalf("got %d modems", len(modems))
+	}
+	macs := map[string]bool{}
+	for _, m := range modems {
+		if a, err := mac.Parse(m.Mac); macs[m.Mac] || err != nil || a.String() != m.Mac {
+			t.Errorf("mac %q is duplicated or malformed", m.Mac)
+		}
+		macs[m.Mac] = true
+		if (m.Fqdn == nil) == (m.Ppod == nil) {
+			t.Errorf("%s: a CMTS has an fqdn or a ppod, never both", m.Mac)
+		}
+		if m.Fqdn != nil && !strings.HasPrefix(*m.Fqdn, "acr") && !strings.HasPrefix(*m.Fqdn, "cbr") && !strings.HasPrefix(*m.Fqdn, "smi") {
+			t.Errorf("%s: fqdn %s is not an acr/cbr/smi CMTS", m.Mac, *m.Fqdn)
+		}
+		if !m.DocsisVersion.IsValid() || !m.State.IsValid() {
+			t.Errorf("%s: invalid docsis %v or state %v", m.Mac, *m.DocsisVersion, *m.State)
+		}
+	}
+}
+
+// TestGeneratedPlantIsQueryable checks that every CMTS of the plant is reachable through ByCmts.
+func TestGeneratedPlantIsQueryable(t *testing.T) {
+	t.Parallel()
+	modems := testPlant.generate()
+	mem := cablemodems.NewMemory(modems)
+	cmts := map[string]int{}
+	for _, m := range modems {
+		if m.Fqdn != nil {
+			cmts[*m.Fqdn]++
+		} else {
+			cmts[strings.ToLower(*m.Ppod)]++
+		}
+	}
+	for name, want := range cmts {
+		got, err := mem.ByCmts(context.Background(), cablemodems.CmtsQuery{Cmts: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != want {
+			t.Errorf("%s: ByCmts found %d of %d modems", name, len(got), want)
+		}
+	}
+}
+
+func TestWriteFixtures(t *testing.T) {
+	t.Parallel()
+	modems := testPlant.generate()
+	dir := t.TempDir()
+	for _, format := range []string{"json", "csv"} {
+		path := filepath.Join(dir, "plant."+format)
+		if err := writeFixture(path, format, modems); err != nil {
+			t.Fatal(err)
+		}
+		loaded, err := cablemodems.LoadFixture(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(loaded, modems) {
+			t.Errorf("%s: the fixture does not load back into the generated plant", format)
+		}
+	}
+}
@@ -4,8 +4,11 @@ go 1.24.4
 
 require (
 	github.com/99designs/gqlgen v0.17.75
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.1
 	github.com/vektah/gqlparser/v2 v2.5.28
 	golang.org/x/tools v0.34.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20250603155806-513f23925822
+	google.golang.org/protobuf v1.36.6
 )
 
 require (
@@ -46,8 +49,7 @@ require (
 	golang.org/x/sync v0.15.0 // indirect
 	golang.org/x/sys v0.33.0 // indirect
 	golang.org/x/text v0.26.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20250324211829-b45e905df463 // indirect
-	google.golang.org/protobuf v1.36.6 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250603155806-513f23925822 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
@@ -7,41 +7,134 @@ package graph
 import (
 	"api-project/graphql-api/gql/graph/cablemodems"
 	"api-project/graphql-api/gql/graph/model"
+	domain "api-project/pkg/cablemodems"
 	"context"
-	"fmt"
+	"time"
 )
 
 // ByMac is the resolver for the byMac field.
 func (r *cableModemsResolver) ByMac(ctx context.Context, obj *cablemodems.CableModems, macAddress []string) ([]*model.CableModem, error) {
-	return cablemodems.ByMacRds(ctx, r.DBRead, macAddress)
+	modems, err := r.Repo.ByMac(ctx, macAddress)
+	if err != nil {
+		return nil, err
+	}
+	return cablemodems.ToModels(modems), nil
 }
 
 // ByCmts is the resolver for the byCmts field.
 func (r *cableModemsResolver) ByCmts(ctx context.Context, obj *cablemodems.CableModems, cmts string, state *model.State, docsis *model.DocsisVersion, single *bool) ([]*model.CableModem, error) {
-	panic(fmt.Errorf("not implemented: ByCmts - byCmts"))
+	q := domain.CmtsQuery{
+		Cmts:   cmts,
+		State:  cablemodems.StateFromModel(state),
+		Docsis: cablemodems.DocsisFromModel(docsis),
+	}
+	if single != nil {
+		q.Single = *single
+	}
+	modems, err := r.Repo.ByCmts(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return cablemodems.ToModels(modems), nil
 }
 
 // ByPoller is the resolver for the byPoller field.
 func (r *cableModemsResolver) ByPoller(ctx context.Context, obj *cablemodems.CableModems, poller model.PollerType, cmts string, state *model.State, docsis *model.DocsisVersion) ([]*model.CableModem, error) {
-	panic(fmt.Errorf("not implemented: ByPoller - byPoller"))
+	modems, err := r.Repo.ByPoller(ctx, domain.PollerQuery{
+		Poller: domain.PollerType(poller),
+		Cmts:   cmts,
+		State:  cablemodems.StateFromModel(state),
+		Docsis: cablemodems.DocsisFromModel(docsis),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cablemodems.ToModels(modems), nil
 }
 
 // Paged is the resolver for the paged field.
 func (r *cableModemsResolver) Paged(ctx context.Context, obj *cablemodems.CableModems, filter *model.CableModemsFilter, first *int32, after *string) (*model.CableModemsConnection, error) {
-	panic(fmt.Errorf("not implemented: Paged - paged"))
+	n := domain.DefaultPageSize
+	if first != nil {
+		n = int(*first)
+	}
+	var cursor string
+	if after != nil {
+		cursor = *after
+	}
+	page, err := r.Repo.Paged(ctx, cablemodems.FilterFromModel(filter), n, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return cablemodems.ConnectionFromPage(page), nil
 }
 
 // HistoricalRegState is the resolver for the historicalRegState field.
-func (r *cableModemsResolver) HistoricalRegState(ctx context.Context, obj *cablemodems.CableModems, mac []string, period model.HistoricalPeriod) ([]*model.TsRegStateDevice, error) {
-	panic(fmt.Errorf("not implemented: HistoricalRegState - historicalRegState"))
+func (r *cableModemsResolver) HistoricalRegState(ctx context.Context, obj *cablemodems.CableModems, mac []string, period model.HistoricalPeriod, from *int32, to *int32) ([]*domain.TsRegStateDevice, error) {
+	p := domain.HistoricalPeriod(period)
+	return r.Repo.HistoricalRegState(ctx, mac, p, cablemodems.TimeRangeFromArgs(p, from, to))
 }
 
 // HistoricalCm is the resolver for the historicalCm field.
-func (r *cableModemsResolver) HistoricalCm(ctx context.Context, obj *cablemodems.CableModems, mac []string) ([]*model.TsCmDevice, error) {
-	panic(fmt.Errorf("not implemented: HistoricalCm - historicalCm"))
+func (r *cableModemsResolver) HistoricalCm(ctx context.Context, obj *cablemodems.CableModems, mac []string, from *int32, to *int32) ([]*domain.TsCmDevice, error) {
+	return r.Repo.HistoricalCm(ctx, mac, cablemodems.TimeRangeFromArgs(domain.Minutely, from, to))
+}
+
+// Upsert is the resolver for the upsert field.
+func (r *cableModemsMutationsResolver) Upsert(ctx context.Context, obj *cablemodems.CableModemsMutations, modems []*model.CableModemInput) ([]*model.CableModem, error) {
+	in := make([]*domain.CableModem, len(modems))
+	for i, m := range modems {
+		in[i] = cablemodems.FromInput(m)
+	}
+	written, err := r.Repo.Upsert(ctx, in, nil)
+	if err != nil {
+		return nil, cablemodems.WriteError(err)
+	}
+	return cablemodems.ToModels(written), nil
+}
+
+// MarkNotFound is the resolver for the markNotFound field.
+func (r *cableModemsMutationsResolver) MarkNotFound(ctx context.Context, obj *cablemodems.CableModemsMutations, macAddress []string, date *string) ([]*model.CableModem, error) {
+	day := time.Now().Format("20060102")
+	if date != nil {
+		day = *date
+	}
+	modems, err := r.Repo.MarkNotFound(ctx, macAddress, day, nil)
+	if err != nil {
+		return nil, cablemodems.WriteError(err)
+	}
+	return cablemodems.ToModels(modems), nil
+}
+
+// Restore is the resolver for the restore field.
+func (r *cableModemsMutationsResolver) Restore(ctx context.Context, obj *cablemodems.CableModemsMutations, macAddress []string) ([]*model.CableModem, error) {
+	modems, err := r.Repo.Restore(ctx, macAddress, nil)
+	if err != nil {
+		return nil, cablemodems.WriteError(err)
+	}
+	return cablemodems.ToModels(modems), nil
+}
+
+// Update is the resolver for the update field.
+func (r *cableModemsMutationsResolver) Update(ctx context.Context, obj *cablemodems.CableModemsMutations, macAddress []string, set model.CableModemAttributes) ([]*model.CableModem, error) {
+	modems, err := r.Repo.Update(ctx, macAddress, &domain.CableModem{
+		CmtsType:   set.CmtsType,
+		DeviceType: set.DeviceType,
+		IsCpe:      set.IsCpe,
+	}, nil)
+	if err != nil {
+		return nil, cablemodems.WriteError(err)
+	}
+	return cablemodems.ToModels(modems), nil
 }
 
 // CableModems returns CableModemsResolver implementation.
 func (r *Resolver) CableModems() CableModemsResolver { return &cableModemsResolver{r} }
 
+// CableModemsMutations returns CableModemsMutationsResolver implementation.
+func (r *Resolver) CableModemsMutations() CableModemsMutationsResolver {
+	return &cableModemsMutationsResolver{r}
+}
+
 type cableModemsResolver struct{ *Resolver }
+type cableModemsMutationsResolver struct{ *Resolver }
@@ -2,204 +2,197 @@ package cablemodems
 
 import (
 	"api-project/graphql-api/gql/graph/model"
-	"context"
-	"database/sql"
+	domain "api-project/pkg/cablemodems"
 	"errors"
-	"fmt"
-	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // CableModems does nothing and just exists to generate the nice namespacing for gqlgen.
 // i.e, query{transponders{byBucket(200)}}{FiberNode}
 type CableModems struct{}
 
-func ByMacRds(ctx context.Context, db *sql.DB, macAddresses []string) (modems []*model.CableModem, err error) {
-	if db == nil {
-		return nil, errors.New("nil db connection")
-	}
-
-	modems, err = inRds(ctx, db, "mac", macAddresses, false)
+// CableModemsMutations is the mutation counterpart of CableModems.
+type CableModemsMutations struct{}
 
-	return modems, err
+// ToModels converts repository results into their gqlgen representation.
+func ToModels(modems []*domain.CableModem) []*model.CableModem {
+	out := make([]*model.CableModem, len(modems))
+	for i, m := range modems {
+		out[i] = ToModel(m)
+	}
+	return out
 }
 
-func inRds(ctx context.Context, db *sql.DB, field string, values []string, single bool) ([]*model.CableModem, error) {
-	if len(values) == 0 {
-		return nil, errors.New("values slice is empty")
+// ToModel converts a single repository result into its gqlgen representation.
+// The enums share their spelling with the schema, so they convert directly.
+func ToModel(m *domain.CableModem) *model.CableModem {
+	out := &model.CableModem{
+		Mac:                m.Mac,
+		CpeMac:             m.CpeMac,
+		MacDomain:          m.MacDomain,
+		CableModemIndex:    m.CableModemIndex,
+		ConfigFile:         m.ConfigFile,
+		Model:              m.Model,
+		FiberNode:          m.FiberNode,
+		Ipv4:               m.Ipv4,
+		Ipv6:               m.Ipv6,
+		CpeIpv4:            m.CpeIpv4,
+		Transponder:        m.Transponder,
+		Ppod:               m.Ppod,
+		Fqdn:               m.Fqdn,
+		NotFoundDate:       m.NotFoundDate,
+		RegState:           m.RegState,
+		FnName:             m.FnName,
+		NumberOfGenerators: m.NumberOfGenerators,
+		RpdName:            m.RpdName,
+		UpdatedAt:          updatedAt(m),
+		Bootr:              m.Bootr,
+		Vendor:             m.Vendor,
+		SwRev:              m.SwRev,
+		OltName:            m.OltName,
+		PonName:            m.PonName,
+		UpdatedAtTs:        m.UpdatedAtTs,
+		IsCpe:              m.IsCpe,
+		CmtsType:           m.CmtsType,
+		DeviceType:         m.DeviceType,
 	}
-
-	result := []*model.CableModem{}
-
-	tempValue := values[0]
-	checkPpodQuery := ""
-	if field == "ppod" {
-		tempValue = strings.ToUpper(values[0])
+	if m.DocsisVersion != nil {
+		d := model.DocsisVersion(*m.DocsisVersion)
+		out.DocsisVersion = &d
 	}
-
-	if !(strings.HasPrefix(tempValue, "acr") || strings.HasPrefix(tempValue, "cbr") || strings.HasPrefix(tempValue, "smi")) {
-		checkPpodQuery = "AND ppod IS NOT NULL"
+	if m.State != nil {
+		s := model.State(*m.State)
+		out.State = &s
 	}
+	return out
+}
 
-	if single && len(values) > 0 {
-		query := fmt.Sprintf(`
-			SELECT * 
-			FROM cablemodems
-			WHERE %s = '%s'
-			AND not_found_date IS NULL
-			AND (
-				(ipv4 IS NOT NULL AND ipv4 != '0.0.0.0' AND ipv4 != '') 
-				OR 
-				(ipv6 IS NOT NULL AND ipv6 != '0000:0000:0000:0000:0000:0000:0000:0000' AND ipv6 != '')
-			)
-			%s
-			LIMIT 1;
-		`, field, tempValue, checkPpodQuery)
-
-		rows, err := db.QueryContext(ctx, query)
-		if err != nil {
-			return nil, err
-		}
-		defer rows.Close()
-
-		var cablemodems []*model.CableModem
-		for rows.Next() {
-			cablemodem := &model.CableModem{}
-			err := rows.Scan(
-				&cablemodem.Mac,
-				&cablemodem.CpeMac,
-				&cablemodem.MacDomain,
-				&cablemodem.CableModemIndex,
-				&cablemodem.ConfigFile,
-				&cablemodem.Model,
-				&cablemodem.FiberNode,
-				&cablemodem.Ipv4,
-				&cablemodem.Ipv6,
-				&cablemodem.CpeIpv4,
-				&cablemodem.Transponder,
-				&cablemodem.DocsisVersion,
-				&cablemodem.Ppod,
-				&cablemodem.Fqdn,
-				&cablemodem.State,
-				&cablemodem.NotFoundDate,
-				&cablemodem.RegState,
-				&cablemodem.FnName,
-				&cablemodem.NumberOfGenerators,
-				&cablemodem.RpdName,
-				&cablemodem.UpdatedAt,
-				&cablemodem.Bootr,
-				&cablemodem.Vendor,
-				&cablemodem.SwRev,
-				&cablemodem.OltName,
-				&cablemodem.PonName,
-				&cablemodem.UpdatedAtTs,
-				&cablemodem.IsCpe,
-				&cablemodem.CmtsType,
-				&cablemodem.DeviceType,
-			)
-			if err != nil {
-				return nil, err
-			}
-			cablemodems = append(cablemodems, cablemodem)
-		}
-
-		err = rows.Err()
-		if err != nil {
-			return nil, err
-		}
-
-		return cablemodems, nil
+// FromInput converts a modem record of the upsert mutation for the repository.
+func FromInput(in *model.CableModemInput) *domain.CableModem {
+	return &domain.CableModem{
+		Mac:                in.Mac,
+		CpeMac:             in.CpeMac,
+		MacDomain:          in.MacDomain,
+		CableModemIndex:    in.CableModemIndex,
+		ConfigFile:         in.ConfigFile,
+		Model:              in.Model,
+		FiberNode:          in.FiberNode,
+		Ipv4:               in.Ipv4,
+		Ipv6:               in.Ipv6,
+		CpeIpv4:            in.CpeIpv4,
+		Transponder:        in.Transponder,
+		DocsisVersion:      DocsisFromModel(in.DocsisVersion),
+		Ppod:               in.Ppod,
+		Fqdn:               in.Fqdn,
+		State:              StateFromModel(in.State),
+		NotFoundDate:       in.NotFoundDate,
+		RegState:           in.RegState,
+		FnName:             in.FnName,
+		NumberOfGenerators: in.NumberOfGenerators,
+		RpdName:            in.RpdName,
+		Bootr:              in.Bootr,
+		Vendor:             in.Vendor,
+		SwRev:              in.SwRev,
+		OltName:            in.OltName,
+		PonName:            in.PonName,
+		IsCpe:              in.IsCpe,
+		CmtsType:           in.CmtsType,
+		DeviceType:         in.DeviceType,
 	}
+}
 
-	getRecordsIn := func(ctxArg context.Context, db *sql.DB, field string, values []string, page, pageSize int) ([]*model.CableModem, error) {
-		offset := page * pageSize
-		placeholderArgs := make([]string, len(values))
-		queryArgs := make([]interface{}, len(values)+2)
-		for i, value := range values {
-			placeholderArgs[i] = fmt.Sprintf("$%d", i+1)
-			queryArgs[i] = value
+// updatedAt reads the stored update time, falling back to its unix seconds.
+func updatedAt(m *domain.CableModem) *time.Time {
+	if m.UpdatedAt != nil {
+		if t, ok := model.ParseTime(*m.UpdatedAt); ok {
+			return &t
 		}
-		queryArgs[len(values)] = pageSize
-		queryArgs[len(values)+1] = offset
+	}
+	if m.UpdatedAtTs != nil {
+		t := time.Unix(int64(*m.UpdatedAtTs), 0).UTC()
+		return &t
+	}
+	return nil
+}
 
-		query := fmt.Sprintf(`
-		SELECT *
-		FROM cablemodems
-		WHERE %s IN (%s)
-		ORDER BY fqdn
-		LIMIT $%d OFFSET $%d;
-	`, field, strings.Join(placeholderArgs, ", "), len(values)+1, len(values)+2)
+// StateFromModel converts an optional schema State argument for the repository.
+func StateFromModel(s *model.State) *domain.State {
+	if s == nil {
+		return nil
+	}
+	out := domain.State(*s)
+	return &out
+}
 
-		rows, err := db.QueryContext(ctx, query, queryArgs...)
-		if err != nil {
-			return nil, err
-		}
-		defer rows.Close()
+// DocsisFromModel converts an optional schema DocsisVersion argument for the repository.
+func DocsisFromModel(d *model.DocsisVersion) *domain.DocsisVersion {
+	if d == nil {
+		return nil
+	}
+	out := domain.DocsisVersion(*d)
+	return &out
+}
 
-		var cablemodems []*model.CableModem
-		for rows.Next() {
-			cablemodem := &model.CableModem{}
-			err := rows.Scan(
-				&cablemodem.Mac,
-				&cablemodem.CpeMac,
-				&cablemodem.MacDomain,
-				&cablemodem.CableModemIndex,
-				&cablemodem.ConfigFile,
-				&cablemodem.Model,
-				&cablemodem.FiberNode,
-				&cablemodem.Ipv4,
-				&cablemodem.Ipv6,
-				&cablemodem.CpeIpv4,
-				&cablemodem.Transponder,
-				&cablemodem.DocsisVersion,
-				&cablemodem.Ppod,
-				&cablemodem.Fqdn,
-				&cablemodem.State,
-				&cablemodem.NotFoundDate,
-				&cablemodem.RegState,
-				&cablemodem.FnName,
-				&cablemodem.NumberOfGenerators,
-				&cablemodem.RpdName,
-				&cablemodem.UpdatedAt,
-				&cablemodem.Bootr,
-				&cablemodem.Vendor,
-				&cablemodem.SwRev,
-				&cablemodem.OltName,
-				&cablemodem.PonName,
-				&cablemodem.UpdatedAtTs,
-				&cablemodem.IsCpe,
-				&cablemodem.CmtsType,
-				&cablemodem.DeviceType,
-			)
-			if err != nil {
-				return nil, err
+// FilterFromModel converts a paged filter for the repository. Its macs are canonical already, having been
+// parsed as MacAddress scalars.
+func FilterFromModel(f *model.CableModemsFilter) *domain.Filter {
+	if f == nil {
+		return nil
+	}
+	out := &domain.Filter{
+		DocsisVersion: DocsisFromModel(f.DocsisVersion),
+		DsInterface:   f.DsInterface,
+		Fqdn:          f.Fqdn,
+		Ppod:          f.Ppod,
+		FiberNode:     f.FiberNode,
+		Transponder:   f.Transponder,
+		MacDomain:     f.MacDomain,
+	}
+	if f.MacAddress != nil {
+		out.MacAddress = &domain.StringFilterEqIn{Eq: f.MacAddress.Eq}
+		for _, mac := range f.MacAddress.In {
+			if mac != nil {
+				out.MacAddress.In = append(out.MacAddress.In, *mac)
 			}
-			cablemodems = append(cablemodems, cablemodem)
-		}
-
-		err = rows.Err()
-		if err != nil {
-			return nil, err
 		}
+	}
+	return out
+}
 
-		return cablemodems, nil
+// ConnectionFromPage converts a repository page into a relay connection.
+func ConnectionFromPage(page *domain.Page) *model.CableModemsConnection {
+	return &model.CableModemsConnection{
+		Edges: ToModels(page.Modems),
+		PageInfo: &model.PageInfo{
+			HasNextPage: page.HasNextPage,
+			EndCursor:   page.EndCursor,
+		},
 	}
+}
 
-	page := 0
-	pageSize := 100_000
-	for {
-		records, err := getRecordsIn(ctx, db, field, values, page, pageSize)
-		if err != nil {
-			return nil, err
-		}
+// TimeRangeFromArgs resolves the optional from/to arguments of the historical fields.
+func TimeRangeFromArgs(period domain.HistoricalPeriod, from, to *int32) domain.TimeRange {
+	var f, t int64
+	if from != nil {
+		f = int64(*from)
+	}
+	if to != nil {
+		t = int64(*to)
+	}
+	return domain.ResolveTimeRange(period, f, t, time.Now())
+}
 
-		if len(records) == 0 {
-			break
+// WriteError reports the write errors a client caused, like a batch repeating a mac, as validation errors,
+// with the code of an invalid argument, and passes any other error through.
+func WriteError(err error) error {
+	if errors.Is(err, domain.ErrDuplicateMac) || errors.Is(err, domain.ErrEmptyPatch) || errors.Is(err, domain.ErrEmptyValues) {
+		return &gqlerror.Error{
+			Message:    err.Error(),
+			Extensions: map[string]interface{}{"code": errcode.ValidationFailed},
 		}
-
-		result = append(result, records...)
-
-		page++
 	}
-
-	return result, nil
+	return err
 }
@@ -0,0 +1,297 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"api-project/pkg/cablemodems"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// newTestClient serves the shared modem fixture from memory.
+func newTestClient(t *testing.T) (*client.Client, *cablemodems.Memory) {
+	t.Helper()
+	modems, err := cablemodems.LoadFixture("../../../pkg/cablemodems/testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mem := cablemodems.NewMemory(modems)
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: &Resolver{Repo: mem}}))
+	srv.AddTransport(transport.POST{})
+	return client.New(srv), mem
+}
+
+func TestCableModemsQueries(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	for _, tt := range []struct {
+		name    string
+		query   string
+		field   string
+		wantErr bool
+		macs    []string
+	}{
+		{"byMac", `{ cableModems { byMac(macAddress: ["5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"]) { mac } } }`, "byMac", false,
+			[]string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"byMac notations", `{ cableModems { byMac(macAddress: ["5C:22:DA:0E:9F:01", "a4b1.e900.0003"]) { mac } } }`, "byMac", false,
+			[]string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"byMac invalid", `{ cableModems { byMac(macAddress: ["5c:22:da:0e:9f:01", "nope"]) { mac } } }`, "", true, nil},
+		{"byCmts", `{ cableModems { byCmts(cmts: "den01") { mac } } }`, "byCmts", false,
+			[]string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"byCmts filters", `{ cableModems { byCmts(cmts: "acr01.den.example.net", state: Offline, docsis: Docsis3) { mac } } }`, "byCmts", false,
+			[]string{"5c:22:da:0e:9f:03"}},
+		{"byCmts single", `{ cableModems { byCmts(cmts: "acr01.den.example.net", single: true) { mac } } }`, "byCmts", false,
+			[]string{"5c:22:da:0e:9f:01"}},
+		{"byCmts bad state", `{ cableModems { byCmts(cmts: "den01", state: Sleeping) { mac } } }`, "", true, nil},
+		{"byPoller", `{ cableModems { byPoller(poller: RX_MER, cmts: "acr01.den.example.net") { mac } } }`, "byPoller", false,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}},
+		{"byPoller unknown", `{ cableModems { byPoller(poller: SNMP, cmts: "den01") { mac } } }`, "", true, nil},
+		{"paged", `{ cableModems { paged(first: 2) { edges { mac } } } }`, "paged", false,
+			[]string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"paged filter", `{ cableModems { paged(filter: {fiberNode: "FN-102"}) { edges { mac } } } }`, "paged", false,
+			[]string{"5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"paged bad mac", `{ cableModems { paged(filter: {macAddress: {eq: "a4b1e9"}}) { edges { mac } } } }`, "", true, nil},
+		{"paged bad cursor", `{ cableModems { paged(after: "not-a-cursor") { edges { mac } } } }`, "", true, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp struct {
+				CableModems map[string]interface{}
+			}
+			err := c.Post(tt.query, &resp)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := macsOf(t, resp.CableModems[tt.field]); !reflect.DeepEqual(got, tt.macs) {
+				t.Errorf("got %v, want %v", got, tt.macs)
+			}
+		})
+	}
+}
+
+func TestPagedEndCursor(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	var macs []string
+	var after *string
+	for i := 0; i < 5; i++ {
+		var resp struct {
+			CableModems struct {
+				Paged struct {
+					Edges    []struct{ Mac string }
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				}
+			}
+		}
+		query := `query($after: String) { cableModems { paged(first: 3, after: $after) { edges { mac } pageInfo { hasNextPage endCursor } } } }`
+		if err := c.Post(query, &resp, client.Var("after", after)); err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range resp.CableModems.Paged.Edges {
+			macs = append(macs, e.Mac)
+		}
+		if !resp.CableModems.Paged.PageInfo.HasNextPage {
+			break
+		}
+		after = &resp.CableModems.Paged.PageInfo.EndCursor
+	}
+	if len(macs) != 7 {
+		t.Errorf("paged through %v, want all 7 modems", macs)
+	}
+}
+
+func TestHistoricalQueries(t *testing.T) {
+	t.Parallel()
+	c, mem := newTestClient(t)
+	mac, regState, resets := "5c:22:da:0e:9f:01", int32(6), int32(3)
+	at := time.Unix(1790000000, 0)
+	if err := mem.RecordRegState(context.Background(), at, []*cablemodems.TsRegStateDevice{{Mac: &mac, RegState: &regState}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mem.RecordCm(context.Background(), at, []*cablemodems.TsCmDevice{{Mac: &mac, Resets: &resets}}); err != nil {
+		t.Fatal(err)
+	}
+
+	var resp struct {
+		CableModems struct {
+			HistoricalRegState []struct {
+				Mac      string
+				RegState int
+			}
+			HistoricalCm []struct {
+				Mac    string
+				Resets int
+			}
+		}
+	}
+	err := c.Post(`query($mac: MacAddress!, $from: Int, $to: Int) { cableModems {
+		historicalRegState(mac: [$mac], period: Minutely, from: $from, to: $to) { mac regState }
+		historicalCm(mac: [$mac], from: $from, to: $to) { mac resets }
+	} }`, &resp, client.Var("mac", mac), client.Var("from", at.Add(-time.Hour).Unix()), client.Var("to", at.Add(time.Hour).Unix()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs := resp.CableModems.HistoricalRegState; len(rs) != 1 || rs[0].RegState != 6 {
+		t.Errorf("historicalRegState: got %+v", rs)
+	}
+	if cm := resp.CableModems.HistoricalCm; len(cm) != 1 || cm[0].Resets != 3 {
+		t.Errorf("historicalCm: got %+v", cm)
+	}
+
+	if err := c.Post(`{ cableModems { historicalRegState(mac: ["x"], period: Daily) { mac } } }`, &resp); err == nil {
+		t.Error("period Daily: expected an error")
+	}
+}
+
+// macsOf returns the macs of a list field or of the edges of a connection.
+func macsOf(t *testing.T, field interface{}) []string {
+	t.Helper()
+	if conn, ok := field.(map[string]interface{}); ok {
+		field = conn["edges"]
+	}
+	macs := []string{}
+	for _, m := range field.([]interface{}) {
+		macs = append(macs, m.(map[string]interface{})["mac"].(string))
+	}
+	return macs
+}
+
+func TestScalarsAreServed(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	var resp struct {
+		CableModems struct {
+			ByMac []struct {
+				Mac          string
+				Ipv6         *string
+				NotFoundDate *string
+				UpdatedAt    *string
+			}
+		}
+	}
+	if err := c.Post(`{ cableModems { byMac(macAddress: ["5c:22:da:0e:9f:04"]) { mac ipv6 notFoundDate updatedAt } } }`, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.CableModems.ByMac) != 1 {
+		t.Fatalf("got %+v", resp.CableModems.ByMac)
+	}
+	m := resp.CableModems.ByMac[0]
+	if m.Mac != "5c:22:da:0e:9f:04" || m.NotFoundDate == nil || *m.NotFoundDate != "20260901" {
+		t.Errorf("got %+v", m)
+	}
+	if m.UpdatedAt == nil || *m.UpdatedAt != "2025-10-09T08:53:20Z" {
+		t.Errorf("updatedAt from updatedAtTs: got %v", m.UpdatedAt)
+	}
+}
+
+func TestCableModemsMutations(t *testing.T) {
+	t.Parallel()
+	c, mem := newTestClient(t)
+	type modem struct {
+		Mac          string
+		NotFoundDate *string
+		CmtsType     *string
+		DeviceType   *int
+		Fqdn         *string
+	}
+	var resp struct {
+		CableModems struct {
+			MarkNotFound []modem
+			Restore      []modem
+			Update       []modem
+			Upsert       []modem
+		}
+	}
+
+	err := c.Post(`mutation { cableModems { markNotFound(macAddress: ["5c:22:da:0e:9f:01", "ff:ff:ff:ff:ff:ff"], date: "2026-10-18") { mac notFoundDate } } }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.CableModems.MarkNotFound; len(got) != 1 || got[0].Mac != "5c:22:da:0e:9f:01" || *got[0].NotFoundDate != "20261018" {
+		t.Errorf("markNotFound: got %+v", got)
+	}
+	if single, _ := mem.ByCmts(context.Background(), cablemodems.CmtsQuery{Cmts: "acr01.den.example.net", Single: true}); single[0].Mac != "5c:22:da:0e:9f:02" {
+		t.Errorf("the modem marked not found is still reachable")
+	}
+
+	if err := c.Post(`mutation { cableModems { restore(macAddress: ["5c:22:da:0e:9f:01"]) { mac notFoundDate } } }`, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.CableModems.Restore; len(got) != 1 || got[0].NotFoundDate != nil {
+		t.Errorf("restore: got %+v", got)
+	}
+
+	err = c.Post(`mutation { cableModems { update(macAddress: ["a4:b1:e9:00:00:02", "a4:b1:e9:00:00:01"], set: {cmtsType: "vcmts", deviceType: 2}) { mac cmtsType deviceType } } }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.CableModems.Update; len(got) != 2 || got[0].Mac != "a4:b1:e9:00:00:01" || *got[1].CmtsType != "vcmts" || *got[1].DeviceType != 2 {
+		t.Errorf("update: got %+v", got)
+	}
+
+	err = c.Post(`mutation { cableModems { upsert(modems: [{mac: "5C-22-DA-0E-9F-FF", fqdn: "acr02.den.example.net", ipv4: "10.0.0.9"}]) { mac fqdn } } }`, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.CableModems.Upsert; len(got) != 1 || got[0].Mac != "5c:22:da:0e:9f:ff" || *got[0].Fqdn != "acr02.den.example.net" {
+		t.Errorf("upsert: got %+v", got)
+	}
+
+	for _, bad := range []string{
+		`mutation { cableModems { update(macAddress: ["a4:b1:e9:00:00:01"], set: {}) { mac } } }`,
+		`mutation { cableModems { markNotFound(macAddress: ["a4:b1:e9:00:00:01"], date: "yesterday") { mac } } }`,
+		`mutation { cableModems { upsert(modems: [{mac: "5c:22:da:0e:9f:ff", ipv4: "2001:db8::1"}]) { mac } } }`,
+	} {
+		if err := c.Post(bad, &resp); err == nil {
+			t.Errorf("%s: expected an error", bad)
+		}
+	}
+}
+
+func TestInvalidMacsAreListed(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	resp, err := c.RawPost(`{ cableModems { byMac(macAddress: ["bad1", "5c:22:da:0e:9f:01", "bad2"]) { mac } } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs gqlerror.List
+	if err := json.Unmarshal(resp.Errors, &errs); err != nil || len(errs) != 1 {
+		t.Fatalf("got errors %s", resp.Errors)
+	}
+	ext := errs[0].Extensions
+	if ext["code"] != "GRAPHQL_VALIDATION_FAILED" || !reflect.DeepEqual(ext["invalid"], []interface{}{"bad1"}) {
+		t.Errorf("got extensions %v", ext)
+	}
+}
+
+func TestUpsertDuplicateMacIsAValidationError(t *testing.T) {
+	t.Parallel()
+	c, _ := newTestClient(t)
+	resp, err := c.RawPost(`mutation { cableModems { upsert(modems: [{mac: "5c:22:da:0e:9f:ff"}, {mac: "5C22.DA0E.9FFF"}]) { mac } } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs gqlerror.List
+	if err := json.Unmarshal(resp.Errors, &errs); err != nil || len(errs) != 1 {
+		t.Fatalf("got errors %s", resp.Errors)
+	}
+	if errs[0].Extensions["code"] != "GRAPHQL_VALIDATION_FAILED" || errs[0].Message != "duplicate mac: 5c:22:da:0e:9f:ff" {
+		t.Errorf("got %+v", errs[0])
+	}
+}
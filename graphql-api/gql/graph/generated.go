@@ -5,6 +5,7 @@ package graph
 import (
 	"api-project/graphql-api/gql/graph/cablemodems"
 	"api-project/graphql-api/gql/graph/model"
+	cablemodems1 "api-project/pkg/cablemodems"
 	"bytes"
 	"context"
 	"embed"
@@ -13,6 +14,7 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -41,6 +43,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	CableModems() CableModemsResolver
+	CableModemsMutations() CableModemsMutationsResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
 }
@@ -86,8 +89,8 @@ type ComplexityRoot struct {
 		ByCmts             func(childComplexity int, cmts string, state *model.State, docsis *model.DocsisVersion, single *bool) int
 		ByMac              func(childComplexity int, macAddress []string) int
 		ByPoller           func(childComplexity int, poller model.PollerType, cmts string, state *model.State, docsis *model.DocsisVersion) int
-		HistoricalCm       func(childComplexity int, mac []string) int
-		HistoricalRegState func(childComplexity int, mac []string, period model.HistoricalPeriod) int
+		HistoricalCm       func(childComplexity int, mac []string, from *int32, to *int32) int
+		HistoricalRegState func(childComplexity int, mac []string, period model.HistoricalPeriod, from *int32, to *int32) int
 		Paged              func(childComplexity int, filter *model.CableModemsFilter, first *int32, after *string) int
 	}
 
@@ -96,8 +99,15 @@ type ComplexityRoot struct {
 		PageInfo func(childComplexity int) int
 	}
 
+	CableModemsMutations struct {
+		MarkNotFound func(childComplexity int, macAddress []string, date *string) int
+		Restore      func(childComplexity int, macAddress []string) int
+		Update       func(childComplexity int, macAddress []string, set model.CableModemAttributes) int
+		Upsert       func(childComplexity int, modems []*model.CableModemInput) int
+	}
+
 	Mutation struct {
-		CreateTodo func(childComplexity int, input model.NewTodo) int
+		CableModems func(childComplexity int) int
 	}
 
 	PageInfo struct {
@@ -109,13 +119,6 @@ type ComplexityRoot struct {
 		CableModems func(childComplexity int) int
 	}
 
-	Todo struct {
-		Done func(childComplexity int) int
-		ID   func(childComplexity int) int
-		Text func(childComplexity int) int
-		User func(childComplexity int) int
-	}
-
 	TsCableDownstream struct {
 		ChannelPower       func(childComplexity int) int
 		Correcteds         func(childComplexity int) int
@@ -175,11 +178,6 @@ type ComplexityRoot struct {
 		RegState func(childComplexity int) int
 		Time     func(childComplexity int) int
 	}
-
-	User struct {
-		ID   func(childComplexity int) int
-		Name func(childComplexity int) int
-	}
 }
 
 type CableModemsResolver interface {
@@ -187,11 +185,17 @@ type CableModemsResolver interface {
 	ByCmts(ctx context.Context, obj *cablemodems.CableModems, cmts string, state *model.State, docsis *model.DocsisVersion, single *bool) ([]*model.CableModem, error)
 	ByPoller(ctx context.Context, obj *cablemodems.CableModems, poller model.PollerType, cmts string, state *model.State, docsis *model.DocsisVersion) ([]*model.CableModem, error)
 	Paged(ctx context.Context, obj *cablemodems.CableModems, filter *model.CableModemsFilter, first *int32, after *string) (*model.CableModemsConnection, error)
-	HistoricalRegState(ctx context.Context, obj *cablemodems.CableModems, mac []string, period model.HistoricalPeriod) ([]*model.TsRegStateDevice, error)
-	HistoricalCm(ctx context.Context, obj *cablemodems.CableModems, mac []string) ([]*model.TsCmDevice, error)
+	HistoricalRegState(ctx context.Context, obj *cablemodems.CableModems, mac []string, period model.HistoricalPeriod, from *int32, to *int32) ([]*cablemodems1.TsRegStateDevice, error)
+	HistoricalCm(ctx context.Context, obj *cablemodems.CableModems, mac []string, from *int32, to *int32) ([]*cablemodems1.TsCmDevice, error)
+}
+type CableModemsMutationsResolver interface {
+	Upsert(ctx context.Context, obj *cablemodems.CableModemsMutations, modems []*model.CableModemInput) ([]*model.CableModem, error)
+	MarkNotFound(ctx context.Context, obj *cablemodems.CableModemsMutations, macAddress []string, date *string) ([]*model.CableModem, error)
+	Restore(ctx context.Context, obj *cablemodems.CableModemsMutations, macAddress []string) ([]*model.CableModem, error)
+	Update(ctx context.Context, obj *cablemodems.CableModemsMutations, macAddress []string, set model.CableModemAttributes) ([]*model.CableModem, error)
 }
 type MutationResolver interface {
-	CreateTodo(ctx context.Context, input model.NewTodo) (*model.Todo, error)
+	CableModems(ctx context.Context) (*cablemodems.CableModemsMutations, error)
 }
 type QueryResolver interface {
 	CableModems(ctx context.Context) (*cablemodems.CableModems, error)
@@ -472,7 +476,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.CableModems.HistoricalCm(childComplexity, args["mac"].([]string)), true
+		return e.complexity.CableModems.HistoricalCm(childComplexity, args["mac"].([]string), args["from"].(*int32), args["to"].(*int32)), true
 
 	case "CableModems.historicalRegState":
 		if e.complexity.CableModems.HistoricalRegState == nil {
@@ -484,7 +488,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.complexity.CableModems.HistoricalRegState(childComplexity, args["mac"].([]string), args["period"].(model.HistoricalPeriod)), true
+		return e.complexity.CableModems.HistoricalRegState(childComplexity, args["mac"].([]string), args["period"].(model.HistoricalPeriod), args["from"].(*int32), args["to"].(*int32)), true
 
 	case "CableModems.paged":
 		if e.complexity.CableModems.Paged == nil {
@@ -512,66 +516,81 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CableModemsConnection.PageInfo(childComplexity), true
 
-	case "Mutation.createTodo":
-		if e.complexity.Mutation.CreateTodo == nil {
+	case "CableModemsMutations.markNotFound":
+		if e.complexity.CableModemsMutations.MarkNotFound == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createTodo_args(ctx, rawArgs)
+		args, err := ec.field_CableModemsMutations_markNotFound_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CreateTodo(childComplexity, args["input"].(model.NewTodo)), true
+		return e.complexity.CableModemsMutations.MarkNotFound(childComplexity, args["macAddress"].([]string), args["date"].(*string)), true
 
-	case "PageInfo.endCursor":
-		if e.complexity.PageInfo.EndCursor == nil {
+	case "CableModemsMutations.restore":
+		if e.complexity.CableModemsMutations.Restore == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.EndCursor(childComplexity), true
+		args, err := ec.field_CableModemsMutations_restore_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PageInfo.hasNextPage":
-		if e.complexity.PageInfo.HasNextPage == nil {
+		return e.complexity.CableModemsMutations.Restore(childComplexity, args["macAddress"].([]string)), true
+
+	case "CableModemsMutations.update":
+		if e.complexity.CableModemsMutations.Update == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+		args, err := ec.field_CableModemsMutations_update_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Query.cableModems":
-		if e.complexity.Query.CableModems == nil {
+		return e.complexity.CableModemsMutations.Update(childComplexity, args["macAddress"].([]string), args["set"].(model.CableModemAttributes)), true
+
+	case "CableModemsMutations.upsert":
+		if e.complexity.CableModemsMutations.Upsert == nil {
 			break
 		}
 
-		return e.complexity.Query.CableModems(childComplexity), true
+		args, err := ec.field_CableModemsMutations_upsert_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Todo.done":
-		if e.complexity.Todo.Done == nil {
+		return e.complexity.CableModemsMutations.Upsert(childComplexity, args["modems"].([]*model.CableModemInput)), true
+
+	case "Mutation.cableModems":
+		if e.complexity.Mutation.CableModems == nil {
 			break
 		}
 
-		return e.complexity.Todo.Done(childComplexity), true
+		return e.complexity.Mutation.CableModems(childComplexity), true
 
-	case "Todo.id":
-		if e.complexity.Todo.ID == nil {
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
 			break
 		}
 
-		return e.complexity.Todo.ID(childComplexity), true
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
 
-	case "Todo.text":
-		if e.complexity.Todo.Text == nil {
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
 			break
 		}
 
-		return e.complexity.Todo.Text(childComplexity), true
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
 
-	case "Todo.user":
-		if e.complexity.Todo.User == nil {
+	case "Query.cableModems":
+		if e.complexity.Query.CableModems == nil {
 			break
 		}
 
-		return e.complexity.Todo.User(childComplexity), true
+		return e.complexity.Query.CableModems(childComplexity), true
 
 	case "TsCableDownstream.channelPower":
 		if e.complexity.TsCableDownstream.ChannelPower == nil {
@@ -825,20 +844,6 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.TsRegStateDevice.Time(childComplexity), true
 
-	case "User.id":
-		if e.complexity.User.ID == nil {
-			break
-		}
-
-		return e.complexity.User.ID(childComplexity), true
-
-	case "User.name":
-		if e.complexity.User.Name == nil {
-			break
-		}
-
-		return e.complexity.User.Name(childComplexity), true
-
 	}
 	return 0, false
 }
@@ -847,9 +852,10 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCableModemAttributes,
+		ec.unmarshalInputCableModemInput,
 		ec.unmarshalInputCableModemsFilter,
-		ec.unmarshalInputNewTodo,
-		ec.unmarshalInputStringFilterEqIn,
+		ec.unmarshalInputMacAddressFilterEqIn,
 	)
 	first := true
 
@@ -967,6 +973,134 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_CableModemsMutations_markNotFound_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_CableModemsMutations_markNotFound_argsMacAddress(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["macAddress"] = arg0
+	arg1, err := ec.field_CableModemsMutations_markNotFound_argsDate(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["date"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_CableModemsMutations_markNotFound_argsMacAddress(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("macAddress"))
+	if tmp, ok := rawArgs["macAddress"]; ok {
+		return ec.unmarshalNMacAddress2ᚕstringᚄ(ctx, tmp)
+	}
+
+	var zeroVal []string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_CableModemsMutations_markNotFound_argsDate(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("date"))
+	if tmp, ok := rawArgs["date"]; ok {
+		return ec.unmarshalODate2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_CableModemsMutations_restore_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_CableModemsMutations_restore_argsMacAddress(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["macAddress"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_CableModemsMutations_restore_argsMacAddress(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("macAddress"))
+	if tmp, ok := rawArgs["macAddress"]; ok {
+		return ec.unmarshalNMacAddress2ᚕstringᚄ(ctx, tmp)
+	}
+
+	var zeroVal []string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_CableModemsMutations_update_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_CableModemsMutations_update_argsMacAddress(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["macAddress"] = arg0
+	arg1, err := ec.field_CableModemsMutations_update_argsSet(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["set"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_CableModemsMutations_update_argsMacAddress(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("macAddress"))
+	if tmp, ok := rawArgs["macAddress"]; ok {
+		return ec.unmarshalNMacAddress2ᚕstringᚄ(ctx, tmp)
+	}
+
+	var zeroVal []string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_CableModemsMutations_update_argsSet(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (model.CableModemAttributes, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("set"))
+	if tmp, ok := rawArgs["set"]; ok {
+		return ec.unmarshalNCableModemAttributes2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemAttributes(ctx, tmp)
+	}
+
+	var zeroVal model.CableModemAttributes
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_CableModemsMutations_upsert_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_CableModemsMutations_upsert_argsModems(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["modems"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_CableModemsMutations_upsert_argsModems(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]*model.CableModemInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("modems"))
+	if tmp, ok := rawArgs["modems"]; ok {
+		return ec.unmarshalNCableModemInput2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.CableModemInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_CableModems_byCmts_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1060,7 +1194,7 @@ func (ec *executionContext) field_CableModems_byMac_argsMacAddress(
 ) ([]string, error) {
 	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("macAddress"))
 	if tmp, ok := rawArgs["macAddress"]; ok {
-		return ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		return ec.unmarshalNMacAddress2ᚕstringᚄ(ctx, tmp)
 	}
 
 	var zeroVal []string
@@ -1152,6 +1286,16 @@ func (ec *executionContext) field_CableModems_historicalCm_args(ctx context.Cont
 		return nil, err
 	}
 	args["mac"] = arg0
+	arg1, err := ec.field_CableModems_historicalCm_argsFrom(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := ec.field_CableModems_historicalCm_argsTo(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
 	return args, nil
 }
 func (ec *executionContext) field_CableModems_historicalCm_argsMac(
@@ -1160,13 +1304,39 @@ func (ec *executionContext) field_CableModems_historicalCm_argsMac(
 ) ([]string, error) {
 	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("mac"))
 	if tmp, ok := rawArgs["mac"]; ok {
-		return ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		return ec.unmarshalNMacAddress2ᚕstringᚄ(ctx, tmp)
 	}
 
 	var zeroVal []string
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_CableModems_historicalCm_argsFrom(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int32, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+	if tmp, ok := rawArgs["from"]; ok {
+		return ec.unmarshalOInt2ᚖint32(ctx, tmp)
+	}
+
+	var zeroVal *int32
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_CableModems_historicalCm_argsTo(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int32, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+	if tmp, ok := rawArgs["to"]; ok {
+		return ec.unmarshalOInt2ᚖint32(ctx, tmp)
+	}
+
+	var zeroVal *int32
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_CableModems_historicalRegState_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1180,6 +1350,16 @@ func (ec *executionContext) field_CableModems_historicalRegState_args(ctx contex
 		return nil, err
 	}
 	args["period"] = arg1
+	arg2, err := ec.field_CableModems_historicalRegState_argsFrom(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg2
+	arg3, err := ec.field_CableModems_historicalRegState_argsTo(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_CableModems_historicalRegState_argsMac(
@@ -1188,7 +1368,7 @@ func (ec *executionContext) field_CableModems_historicalRegState_argsMac(
 ) ([]string, error) {
 	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("mac"))
 	if tmp, ok := rawArgs["mac"]; ok {
-		return ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		return ec.unmarshalOMacAddress2ᚕstringᚄ(ctx, tmp)
 	}
 
 	var zeroVal []string
@@ -1208,6 +1388,32 @@ func (ec *executionContext) field_CableModems_historicalRegState_argsPeriod(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_CableModems_historicalRegState_argsFrom(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int32, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+	if tmp, ok := rawArgs["from"]; ok {
+		return ec.unmarshalOInt2ᚖint32(ctx, tmp)
+	}
+
+	var zeroVal *int32
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_CableModems_historicalRegState_argsTo(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int32, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+	if tmp, ok := rawArgs["to"]; ok {
+		return ec.unmarshalOInt2ᚖint32(ctx, tmp)
+	}
+
+	var zeroVal *int32
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_CableModems_paged_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1267,29 +1473,6 @@ func (ec *executionContext) field_CableModems_paged_argsAfter(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_createTodo_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Mutation_createTodo_argsInput(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Mutation_createTodo_argsInput(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (model.NewTodo, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
-	if tmp, ok := rawArgs["input"]; ok {
-		return ec.unmarshalNNewTodo2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐNewTodo(ctx, tmp)
-	}
-
-	var zeroVal model.NewTodo
-	return zeroVal, nil
-}
-
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1441,7 +1624,7 @@ func (ec *executionContext) _CableModem_mac(ctx context.Context, field graphql.C
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNMacAddress2string(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModem_mac(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -1451,7 +1634,7 @@ func (ec *executionContext) fieldContext_CableModem_mac(_ context.Context, field
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MacAddress does not have child fields")
 		},
 	}
 	return fc, nil
@@ -1482,7 +1665,7 @@ func (ec *executionContext) _CableModem_cpeMac(ctx context.Context, field graphq
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMacAddress2ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModem_cpeMac(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -1492,7 +1675,7 @@ func (ec *executionContext) fieldContext_CableModem_cpeMac(_ context.Context, fi
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MacAddress does not have child fields")
 		},
 	}
 	return fc, nil
@@ -1728,7 +1911,7 @@ func (ec *executionContext) _CableModem_ipv4(ctx context.Context, field graphql.
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOIPv42ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModem_ipv4(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -1738,7 +1921,7 @@ func (ec *executionContext) fieldContext_CableModem_ipv4(_ context.Context, fiel
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type IPv4 does not have child fields")
 		},
 	}
 	return fc, nil
@@ -1769,7 +1952,7 @@ func (ec *executionContext) _CableModem_ipv6(ctx context.Context, field graphql.
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOIPv62ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModem_ipv6(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -1779,7 +1962,7 @@ func (ec *executionContext) fieldContext_CableModem_ipv6(_ context.Context, fiel
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type IPv6 does not have child fields")
 		},
 	}
 	return fc, nil
@@ -1810,7 +1993,7 @@ func (ec *executionContext) _CableModem_cpeIpv4(ctx context.Context, field graph
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOIPv42ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModem_cpeIpv4(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -1820,7 +2003,7 @@ func (ec *executionContext) fieldContext_CableModem_cpeIpv4(_ context.Context, f
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type IPv4 does not have child fields")
 		},
 	}
 	return fc, nil
@@ -2056,7 +2239,7 @@ func (ec *executionContext) _CableModem_notFoundDate(ctx context.Context, field
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalODate2ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModem_notFoundDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -2066,7 +2249,7 @@ func (ec *executionContext) fieldContext_CableModem_notFoundDate(_ context.Conte
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
@@ -2259,9 +2442,9 @@ func (ec *executionContext) _CableModem_updatedAt(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModem_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -2271,7 +2454,7 @@ func (ec *executionContext) fieldContext_CableModem_updatedAt(_ context.Context,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -3069,7 +3252,7 @@ func (ec *executionContext) _CableModems_historicalRegState(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CableModems().HistoricalRegState(rctx, obj, fc.Args["mac"].([]string), fc.Args["period"].(model.HistoricalPeriod))
+		return ec.resolvers.CableModems().HistoricalRegState(rctx, obj, fc.Args["mac"].([]string), fc.Args["period"].(model.HistoricalPeriod), fc.Args["from"].(*int32), fc.Args["to"].(*int32))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3078,9 +3261,9 @@ func (ec *executionContext) _CableModems_historicalRegState(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsRegStateDevice)
+	res := resTmp.([]*cablemodems1.TsRegStateDevice)
 	fc.Result = res
-	return ec.marshalOTsRegStateDevice2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsRegStateDeviceᚄ(ctx, field.Selections, res)
+	return ec.marshalOTsRegStateDevice2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsRegStateDeviceᚄ(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModems_historicalRegState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -3129,7 +3312,7 @@ func (ec *executionContext) _CableModems_historicalCm(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.CableModems().HistoricalCm(rctx, obj, fc.Args["mac"].([]string))
+		return ec.resolvers.CableModems().HistoricalCm(rctx, obj, fc.Args["mac"].([]string), fc.Args["from"].(*int32), fc.Args["to"].(*int32))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3138,9 +3321,9 @@ func (ec *executionContext) _CableModems_historicalCm(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsCmDevice)
+	res := resTmp.([]*cablemodems1.TsCmDevice)
 	fc.Result = res
-	return ec.marshalOTsCmDevice2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmDeviceᚄ(ctx, field.Selections, res)
+	return ec.marshalOTsCmDevice2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmDeviceᚄ(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_CableModems_historicalCm(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -3338,8 +3521,8 @@ func (ec *executionContext) fieldContext_CableModemsConnection_pageInfo(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createTodo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createTodo(ctx, field)
+func (ec *executionContext) _CableModemsMutations_upsert(ctx context.Context, field graphql.CollectedField, obj *cablemodems.CableModemsMutations) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CableModemsMutations_upsert(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3352,7 +3535,7 @@ func (ec *executionContext) _Mutation_createTodo(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateTodo(rctx, fc.Args["input"].(model.NewTodo))
+		return ec.resolvers.CableModemsMutations().Upsert(rctx, obj, fc.Args["modems"].([]*model.CableModemInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3364,63 +3547,520 @@ func (ec *executionContext) _Mutation_createTodo(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Todo)
+	res := resTmp.([]*model.CableModem)
 	fc.Result = res
-	return ec.marshalNTodo2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTodo(ctx, field.Selections, res)
+	return ec.marshalNCableModem2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createTodo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CableModemsMutations_upsert(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CableModemsMutations",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Todo_id(ctx, field)
-			case "text":
-				return ec.fieldContext_Todo_text(ctx, field)
-			case "done":
-				return ec.fieldContext_Todo_done(ctx, field)
-			case "user":
-				return ec.fieldContext_Todo_user(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Todo", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createTodo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.HasNextPage, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+			case "mac":
+				return ec.fieldContext_CableModem_mac(ctx, field)
+			case "cpeMac":
+				return ec.fieldContext_CableModem_cpeMac(ctx, field)
+			case "macDomain":
+				return ec.fieldContext_CableModem_macDomain(ctx, field)
+			case "cableModemIndex":
+				return ec.fieldContext_CableModem_cableModemIndex(ctx, field)
+			case "configFile":
+				return ec.fieldContext_CableModem_configFile(ctx, field)
+			case "model":
+				return ec.fieldContext_CableModem_model(ctx, field)
+			case "fiberNode":
+				return ec.fieldContext_CableModem_fiberNode(ctx, field)
+			case "ipv4":
+				return ec.fieldContext_CableModem_ipv4(ctx, field)
+			case "ipv6":
+				return ec.fieldContext_CableModem_ipv6(ctx, field)
+			case "cpeIpv4":
+				return ec.fieldContext_CableModem_cpeIpv4(ctx, field)
+			case "transponder":
+				return ec.fieldContext_CableModem_transponder(ctx, field)
+			case "docsisVersion":
+				return ec.fieldContext_CableModem_docsisVersion(ctx, field)
+			case "ppod":
+				return ec.fieldContext_CableModem_ppod(ctx, field)
+			case "fqdn":
+				return ec.fieldContext_CableModem_fqdn(ctx, field)
+			case "state":
+				return ec.fieldContext_CableModem_state(ctx, field)
+			case "notFoundDate":
+				return ec.fieldContext_CableModem_notFoundDate(ctx, field)
+			case "regState":
+				return ec.fieldContext_CableModem_regState(ctx, field)
+			case "fnName":
+				return ec.fieldContext_CableModem_fnName(ctx, field)
+			case "numberOfGenerators":
+				return ec.fieldContext_CableModem_numberOfGenerators(ctx, field)
+			case "rpdName":
+				return ec.fieldContext_CableModem_rpdName(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CableModem_updatedAt(ctx, field)
+			case "bootr":
+				return ec.fieldContext_CableModem_bootr(ctx, field)
+			case "vendor":
+				return ec.fieldContext_CableModem_vendor(ctx, field)
+			case "swRev":
+				return ec.fieldContext_CableModem_swRev(ctx, field)
+			case "oltName":
+				return ec.fieldContext_CableModem_oltName(ctx, field)
+			case "ponName":
+				return ec.fieldContext_CableModem_ponName(ctx, field)
+			case "updatedAtTs":
+				return ec.fieldContext_CableModem_updatedAtTs(ctx, field)
+			case "isCPE":
+				return ec.fieldContext_CableModem_isCPE(ctx, field)
+			case "cmtsType":
+				return ec.fieldContext_CableModem_cmtsType(ctx, field)
+			case "deviceType":
+				return ec.fieldContext_CableModem_deviceType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CableModem", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_CableModemsMutations_upsert_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CableModemsMutations_markNotFound(ctx context.Context, field graphql.CollectedField, obj *cablemodems.CableModemsMutations) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CableModemsMutations_markNotFound(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CableModemsMutations().MarkNotFound(rctx, obj, fc.Args["macAddress"].([]string), fc.Args["date"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CableModem)
+	fc.Result = res
+	return ec.marshalNCableModem2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CableModemsMutations_markNotFound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CableModemsMutations",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "mac":
+				return ec.fieldContext_CableModem_mac(ctx, field)
+			case "cpeMac":
+				return ec.fieldContext_CableModem_cpeMac(ctx, field)
+			case "macDomain":
+				return ec.fieldContext_CableModem_macDomain(ctx, field)
+			case "cableModemIndex":
+				return ec.fieldContext_CableModem_cableModemIndex(ctx, field)
+			case "configFile":
+				return ec.fieldContext_CableModem_configFile(ctx, field)
+			case "model":
+				return ec.fieldContext_CableModem_model(ctx, field)
+			case "fiberNode":
+				return ec.fieldContext_CableModem_fiberNode(ctx, field)
+			case "ipv4":
+				return ec.fieldContext_CableModem_ipv4(ctx, field)
+			case "ipv6":
+				return ec.fieldContext_CableModem_ipv6(ctx, field)
+			case "cpeIpv4":
+				return ec.fieldContext_CableModem_cpeIpv4(ctx, field)
+			case "transponder":
+				return ec.fieldContext_CableModem_transponder(ctx, field)
+			case "docsisVersion":
+				return ec.fieldContext_CableModem_docsisVersion(ctx, field)
+			case "ppod":
+				return ec.fieldContext_CableModem_ppod(ctx, field)
+			case "fqdn":
+				return ec.fieldContext_CableModem_fqdn(ctx, field)
+			case "state":
+				return ec.fieldContext_CableModem_state(ctx, field)
+			case "notFoundDate":
+				return ec.fieldContext_CableModem_notFoundDate(ctx, field)
+			case "regState":
+				return ec.fieldContext_CableModem_regState(ctx, field)
+			case "fnName":
+				return ec.fieldContext_CableModem_fnName(ctx, field)
+			case "numberOfGenerators":
+				return ec.fieldContext_CableModem_numberOfGenerators(ctx, field)
+			case "rpdName":
+				return ec.fieldContext_CableModem_rpdName(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CableModem_updatedAt(ctx, field)
+			case "bootr":
+				return ec.fieldContext_CableModem_bootr(ctx, field)
+			case "vendor":
+				return ec.fieldContext_CableModem_vendor(ctx, field)
+			case "swRev":
+				return ec.fieldContext_CableModem_swRev(ctx, field)
+			case "oltName":
+				return ec.fieldContext_CableModem_oltName(ctx, field)
+			case "ponName":
+				return ec.fieldContext_CableModem_ponName(ctx, field)
+			case "updatedAtTs":
+				return ec.fieldContext_CableModem_updatedAtTs(ctx, field)
+			case "isCPE":
+				return ec.fieldContext_CableModem_isCPE(ctx, field)
+			case "cmtsType":
+				return ec.fieldContext_CableModem_cmtsType(ctx, field)
+			case "deviceType":
+				return ec.fieldContext_CableModem_deviceType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CableModem", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_CableModemsMutations_markNotFound_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CableModemsMutations_restore(ctx context.Context, field graphql.CollectedField, obj *cablemodems.CableModemsMutations) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CableModemsMutations_restore(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CableModemsMutations().Restore(rctx, obj, fc.Args["macAddress"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CableModem)
+	fc.Result = res
+	return ec.marshalNCableModem2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CableModemsMutations_restore(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CableModemsMutations",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "mac":
+				return ec.fieldContext_CableModem_mac(ctx, field)
+			case "cpeMac":
+				return ec.fieldContext_CableModem_cpeMac(ctx, field)
+			case "macDomain":
+				return ec.fieldContext_CableModem_macDomain(ctx, field)
+			case "cableModemIndex":
+				return ec.fieldContext_CableModem_cableModemIndex(ctx, field)
+			case "configFile":
+				return ec.fieldContext_CableModem_configFile(ctx, field)
+			case "model":
+				return ec.fieldContext_CableModem_model(ctx, field)
+			case "fiberNode":
+				return ec.fieldContext_CableModem_fiberNode(ctx, field)
+			case "ipv4":
+				return ec.fieldContext_CableModem_ipv4(ctx, field)
+			case "ipv6":
+				return ec.fieldContext_CableModem_ipv6(ctx, field)
+			case "cpeIpv4":
+				return ec.fieldContext_CableModem_cpeIpv4(ctx, field)
+			case "transponder":
+				return ec.fieldContext_CableModem_transponder(ctx, field)
+			case "docsisVersion":
+				return ec.fieldContext_CableModem_docsisVersion(ctx, field)
+			case "ppod":
+				return ec.fieldContext_CableModem_ppod(ctx, field)
+			case "fqdn":
+				return ec.fieldContext_CableModem_fqdn(ctx, field)
+			case "state":
+				return ec.fieldContext_CableModem_state(ctx, field)
+			case "notFoundDate":
+				return ec.fieldContext_CableModem_notFoundDate(ctx, field)
+			case "regState":
+				return ec.fieldContext_CableModem_regState(ctx, field)
+			case "fnName":
+				return ec.fieldContext_CableModem_fnName(ctx, field)
+			case "numberOfGenerators":
+				return ec.fieldContext_CableModem_numberOfGenerators(ctx, field)
+			case "rpdName":
+				return ec.fieldContext_CableModem_rpdName(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CableModem_updatedAt(ctx, field)
+			case "bootr":
+				return ec.fieldContext_CableModem_bootr(ctx, field)
+			case "vendor":
+				return ec.fieldContext_CableModem_vendor(ctx, field)
+			case "swRev":
+				return ec.fieldContext_CableModem_swRev(ctx, field)
+			case "oltName":
+				return ec.fieldContext_CableModem_oltName(ctx, field)
+			case "ponName":
+				return ec.fieldContext_CableModem_ponName(ctx, field)
+			case "updatedAtTs":
+				return ec.fieldContext_CableModem_updatedAtTs(ctx, field)
+			case "isCPE":
+				return ec.fieldContext_CableModem_isCPE(ctx, field)
+			case "cmtsType":
+				return ec.fieldContext_CableModem_cmtsType(ctx, field)
+			case "deviceType":
+				return ec.fieldContext_CableModem_deviceType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CableModem", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_CableModemsMutations_restore_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CableModemsMutations_update(ctx context.Context, field graphql.CollectedField, obj *cablemodems.CableModemsMutations) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CableModemsMutations_update(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CableModemsMutations().Update(rctx, obj, fc.Args["macAddress"].([]string), fc.Args["set"].(model.CableModemAttributes))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CableModem)
+	fc.Result = res
+	return ec.marshalNCableModem2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CableModemsMutations_update(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CableModemsMutations",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "mac":
+				return ec.fieldContext_CableModem_mac(ctx, field)
+			case "cpeMac":
+				return ec.fieldContext_CableModem_cpeMac(ctx, field)
+			case "macDomain":
+				return ec.fieldContext_CableModem_macDomain(ctx, field)
+			case "cableModemIndex":
+				return ec.fieldContext_CableModem_cableModemIndex(ctx, field)
+			case "configFile":
+				return ec.fieldContext_CableModem_configFile(ctx, field)
+			case "model":
+				return ec.fieldContext_CableModem_model(ctx, field)
+			case "fiberNode":
+				return ec.fieldContext_CableModem_fiberNode(ctx, field)
+			case "ipv4":
+				return ec.fieldContext_CableModem_ipv4(ctx, field)
+			case "ipv6":
+				return ec.fieldContext_CableModem_ipv6(ctx, field)
+			case "cpeIpv4":
+				return ec.fieldContext_CableModem_cpeIpv4(ctx, field)
+			case "transponder":
+				return ec.fieldContext_CableModem_transponder(ctx, field)
+			case "docsisVersion":
+				return ec.fieldContext_CableModem_docsisVersion(ctx, field)
+			case "ppod":
+				return ec.fieldContext_CableModem_ppod(ctx, field)
+			case "fqdn":
+				return ec.fieldContext_CableModem_fqdn(ctx, field)
+			case "state":
+				return ec.fieldContext_CableModem_state(ctx, field)
+			case "notFoundDate":
+				return ec.fieldContext_CableModem_notFoundDate(ctx, field)
+			case "regState":
+				return ec.fieldContext_CableModem_regState(ctx, field)
+			case "fnName":
+				return ec.fieldContext_CableModem_fnName(ctx, field)
+			case "numberOfGenerators":
+				return ec.fieldContext_CableModem_numberOfGenerators(ctx, field)
+			case "rpdName":
+				return ec.fieldContext_CableModem_rpdName(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CableModem_updatedAt(ctx, field)
+			case "bootr":
+				return ec.fieldContext_CableModem_bootr(ctx, field)
+			case "vendor":
+				return ec.fieldContext_CableModem_vendor(ctx, field)
+			case "swRev":
+				return ec.fieldContext_CableModem_swRev(ctx, field)
+			case "oltName":
+				return ec.fieldContext_CableModem_oltName(ctx, field)
+			case "ponName":
+				return ec.fieldContext_CableModem_ponName(ctx, field)
+			case "updatedAtTs":
+				return ec.fieldContext_CableModem_updatedAtTs(ctx, field)
+			case "isCPE":
+				return ec.fieldContext_CableModem_isCPE(ctx, field)
+			case "cmtsType":
+				return ec.fieldContext_CableModem_cmtsType(ctx, field)
+			case "deviceType":
+				return ec.fieldContext_CableModem_deviceType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CableModem", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_CableModemsMutations_update_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_cableModems(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_cableModems(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CableModems(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*cablemodems.CableModemsMutations)
+	fc.Result = res
+	return ec.marshalNCableModemsMutations2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋcablemodemsᚐCableModemsMutations(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_cableModems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "upsert":
+				return ec.fieldContext_CableModemsMutations_upsert(ctx, field)
+			case "markNotFound":
+				return ec.fieldContext_CableModemsMutations_markNotFound(ctx, field)
+			case "restore":
+				return ec.fieldContext_CableModemsMutations_restore(ctx, field)
+			case "update":
+				return ec.fieldContext_CableModemsMutations_update(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CableModemsMutations", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
@@ -3680,189 +4320,7 @@ func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Todo_id(ctx context.Context, field graphql.CollectedField, obj *model.Todo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Todo_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Todo_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Todo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Todo_text(ctx context.Context, field graphql.CollectedField, obj *model.Todo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Todo_text(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Text, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Todo_text(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Todo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Todo_done(ctx context.Context, field graphql.CollectedField, obj *model.Todo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Todo_done(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Done, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Todo_done(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Todo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Todo_user(ctx context.Context, field graphql.CollectedField, obj *model.Todo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Todo_user(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(*model.User)
-	fc.Result = res
-	return ec.marshalNUser2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐUser(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Todo_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Todo",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _TsCableDownstream_ifIndex(ctx context.Context, field graphql.CollectedField, obj *model.TsCableDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableDownstream_ifIndex(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableDownstream_ifIndex(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -3903,7 +4361,7 @@ func (ec *executionContext) fieldContext_TsCableDownstream_ifIndex(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableDownstream_channelPower(ctx context.Context, field graphql.CollectedField, obj *model.TsCableDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableDownstream_channelPower(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableDownstream_channelPower(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -3944,7 +4402,7 @@ func (ec *executionContext) fieldContext_TsCableDownstream_channelPower(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableDownstream_unerroreds(ctx context.Context, field graphql.CollectedField, obj *model.TsCableDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableDownstream_unerroreds(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableDownstream_unerroreds(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -3985,7 +4443,7 @@ func (ec *executionContext) fieldContext_TsCableDownstream_unerroreds(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableDownstream_correcteds(ctx context.Context, field graphql.CollectedField, obj *model.TsCableDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableDownstream_correcteds(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableDownstream_correcteds(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4026,7 +4484,7 @@ func (ec *executionContext) fieldContext_TsCableDownstream_correcteds(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableDownstream_uncorrectables(ctx context.Context, field graphql.CollectedField, obj *model.TsCableDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableDownstream_uncorrectables(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableDownstream_uncorrectables(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4067,7 +4525,7 @@ func (ec *executionContext) fieldContext_TsCableDownstream_uncorrectables(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableDownstream_signalNoiseDecibel(ctx context.Context, field graphql.CollectedField, obj *model.TsCableDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableDownstream_signalNoiseDecibel(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableDownstream_signalNoiseDecibel(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4108,7 +4566,7 @@ func (ec *executionContext) fieldContext_TsCableDownstream_signalNoiseDecibel(_
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableUpstream_ifIndex(ctx context.Context, field graphql.CollectedField, obj *model.TsCableUpstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableUpstream_ifIndex(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableUpstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableUpstream_ifIndex(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4149,7 +4607,7 @@ func (ec *executionContext) fieldContext_TsCableUpstream_ifIndex(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableUpstream_statusTxPower(ctx context.Context, field graphql.CollectedField, obj *model.TsCableUpstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableUpstream_statusTxPower(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableUpstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableUpstream_statusTxPower(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4190,7 +4648,7 @@ func (ec *executionContext) fieldContext_TsCableUpstream_statusTxPower(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableUpstream_statusT3Timeouts(ctx context.Context, field graphql.CollectedField, obj *model.TsCableUpstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableUpstream_statusT3Timeouts(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableUpstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableUpstream_statusT3Timeouts(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4231,7 +4689,7 @@ func (ec *executionContext) fieldContext_TsCableUpstream_statusT3Timeouts(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableUpstream_statusT4Timeouts(ctx context.Context, field graphql.CollectedField, obj *model.TsCableUpstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableUpstream_statusT4Timeouts(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableUpstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableUpstream_statusT4Timeouts(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4272,7 +4730,7 @@ func (ec *executionContext) fieldContext_TsCableUpstream_statusT4Timeouts(_ cont
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableUpstreamStatus_ifDescr(ctx context.Context, field graphql.CollectedField, obj *model.TsCableUpstreamStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableUpstreamStatus_ifDescr(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableUpstreamStatus) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableUpstreamStatus_ifDescr(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4313,7 +4771,7 @@ func (ec *executionContext) fieldContext_TsCableUpstreamStatus_ifDescr(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableUpstreamStatus_snr(ctx context.Context, field graphql.CollectedField, obj *model.TsCableUpstreamStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableUpstreamStatus_snr(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableUpstreamStatus) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableUpstreamStatus_snr(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4354,7 +4812,7 @@ func (ec *executionContext) fieldContext_TsCableUpstreamStatus_snr(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCableUpstreamStatus_rxPower(ctx context.Context, field graphql.CollectedField, obj *model.TsCableUpstreamStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCableUpstreamStatus_rxPower(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCableUpstreamStatus) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCableUpstreamStatus_rxPower(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4395,7 +4853,7 @@ func (ec *executionContext) fieldContext_TsCableUpstreamStatus_rxPower(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_mac(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_mac(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_mac(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4420,7 +4878,7 @@ func (ec *executionContext) _TsCmDevice_mac(ctx context.Context, field graphql.C
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMacAddress2ᚖstring(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TsCmDevice_mac(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -4430,13 +4888,13 @@ func (ec *executionContext) fieldContext_TsCmDevice_mac(_ context.Context, field
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MacAddress does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_time(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_time(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_time(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4477,7 +4935,7 @@ func (ec *executionContext) fieldContext_TsCmDevice_time(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_lostSync(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_lostSync(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_lostSync(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4518,7 +4976,7 @@ func (ec *executionContext) fieldContext_TsCmDevice_lostSync(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_resets(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_resets(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_resets(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4559,7 +5017,7 @@ func (ec *executionContext) fieldContext_TsCmDevice_resets(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_cableDownstream(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_cableDownstream(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_cableDownstream(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4582,9 +5040,9 @@ func (ec *executionContext) _TsCmDevice_cableDownstream(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsCableDownstream)
+	res := resTmp.([]*cablemodems1.TsCableDownstream)
 	fc.Result = res
-	return ec.marshalOTsCableDownstream2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableDownstream(ctx, field.Selections, res)
+	return ec.marshalOTsCableDownstream2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableDownstream(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TsCmDevice_cableDownstream(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -4614,7 +5072,7 @@ func (ec *executionContext) fieldContext_TsCmDevice_cableDownstream(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_cableUpstream(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_cableUpstream(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_cableUpstream(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4637,9 +5095,9 @@ func (ec *executionContext) _TsCmDevice_cableUpstream(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsCableUpstream)
+	res := resTmp.([]*cablemodems1.TsCableUpstream)
 	fc.Result = res
-	return ec.marshalOTsCableUpstream2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstream(ctx, field.Selections, res)
+	return ec.marshalOTsCableUpstream2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstream(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TsCmDevice_cableUpstream(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -4665,7 +5123,7 @@ func (ec *executionContext) fieldContext_TsCmDevice_cableUpstream(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_cableUpstreamStatus(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_cableUpstreamStatus(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_cableUpstreamStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4688,9 +5146,9 @@ func (ec *executionContext) _TsCmDevice_cableUpstreamStatus(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsCableUpstreamStatus)
+	res := resTmp.([]*cablemodems1.TsCableUpstreamStatus)
 	fc.Result = res
-	return ec.marshalOTsCableUpstreamStatus2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstreamStatus(ctx, field.Selections, res)
+	return ec.marshalOTsCableUpstreamStatus2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstreamStatus(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TsCmDevice_cableUpstreamStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -4714,7 +5172,7 @@ func (ec *executionContext) fieldContext_TsCmDevice_cableUpstreamStatus(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmDevice_ofdmDownstream(ctx context.Context, field graphql.CollectedField, obj *model.TsCmDevice) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmDevice_ofdmDownstream(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmDevice) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmDevice_ofdmDownstream(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4737,9 +5195,9 @@ func (ec *executionContext) _TsCmDevice_ofdmDownstream(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsOfdmDownstream)
+	res := resTmp.([]*cablemodems1.TsOfdmDownstream)
 	fc.Result = res
-	return ec.marshalOTsOfdmDownstream2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsOfdmDownstream(ctx, field.Selections, res)
+	return ec.marshalOTsOfdmDownstream2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsOfdmDownstream(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TsCmDevice_ofdmDownstream(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -4767,7 +5225,7 @@ func (ec *executionContext) fieldContext_TsCmDevice_ofdmDownstream(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmOfdmChannelPower_channelBandIndex(ctx context.Context, field graphql.CollectedField, obj *model.TsCmOfdmChannelPower) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmOfdmChannelPower_channelBandIndex(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmOfdmChannelPower) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmOfdmChannelPower_channelBandIndex(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4808,7 +5266,7 @@ func (ec *executionContext) fieldContext_TsCmOfdmChannelPower_channelBandIndex(_
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmOfdmChannelPower_centerFrequency(ctx context.Context, field graphql.CollectedField, obj *model.TsCmOfdmChannelPower) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmOfdmChannelPower_centerFrequency(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmOfdmChannelPower) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmOfdmChannelPower_centerFrequency(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4849,7 +5307,7 @@ func (ec *executionContext) fieldContext_TsCmOfdmChannelPower_centerFrequency(_
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmOfdmChannelPower_rxPower(ctx context.Context, field graphql.CollectedField, obj *model.TsCmOfdmChannelPower) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmOfdmChannelPower_rxPower(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmOfdmChannelPower) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmOfdmChannelPower_rxPower(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4890,7 +5348,7 @@ func (ec *executionContext) fieldContext_TsCmOfdmChannelPower_rxPower(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmOfdmChannelProfileStats_cmtsProfileId(ctx context.Context, field graphql.CollectedField, obj *model.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmOfdmChannelProfileStats_cmtsProfileId(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmOfdmChannelProfileStats_cmtsProfileId(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4931,7 +5389,7 @@ func (ec *executionContext) fieldContext_TsCmOfdmChannelProfileStats_cmtsProfile
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmOfdmChannelProfileStats_correctedCodewords(ctx context.Context, field graphql.CollectedField, obj *model.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmOfdmChannelProfileStats_correctedCodewords(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmOfdmChannelProfileStats_correctedCodewords(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -4972,7 +5430,7 @@ func (ec *executionContext) fieldContext_TsCmOfdmChannelProfileStats_correctedCo
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmOfdmChannelProfileStats_uncorrectableCodeword(ctx context.Context, field graphql.CollectedField, obj *model.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmOfdmChannelProfileStats_uncorrectableCodeword(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmOfdmChannelProfileStats_uncorrectableCodeword(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -5013,7 +5471,7 @@ func (ec *executionContext) fieldContext_TsCmOfdmChannelProfileStats_uncorrectab
 	return fc, nil
 }
 
-func (ec *executionContext) _TsCmOfdmChannelProfileStats_totalCodewords(ctx context.Context, field graphql.CollectedField, obj *model.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsCmOfdmChannelProfileStats_totalCodewords(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsCmOfdmChannelProfileStats) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsCmOfdmChannelProfileStats_totalCodewords(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -5054,7 +5512,7 @@ func (ec *executionContext) fieldContext_TsCmOfdmChannelProfileStats_totalCodewo
 	return fc, nil
 }
 
-func (ec *executionContext) _TsOfdmDownstream_ifIndex(ctx context.Context, field graphql.CollectedField, obj *model.TsOfdmDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsOfdmDownstream_ifIndex(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsOfdmDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsOfdmDownstream_ifIndex(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -5095,7 +5553,7 @@ func (ec *executionContext) fieldContext_TsOfdmDownstream_ifIndex(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _TsOfdmDownstream_rxMerMean(ctx context.Context, field graphql.CollectedField, obj *model.TsOfdmDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsOfdmDownstream_rxMerMean(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsOfdmDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsOfdmDownstream_rxMerMean(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -5136,7 +5594,7 @@ func (ec *executionContext) fieldContext_TsOfdmDownstream_rxMerMean(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _TsOfdmDownstream_rxMer2ndPercentile(ctx context.Context, field graphql.CollectedField, obj *model.TsOfdmDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsOfdmDownstream_rxMer2ndPercentile(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsOfdmDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsOfdmDownstream_rxMer2ndPercentile(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -5177,7 +5635,7 @@ func (ec *executionContext) fieldContext_TsOfdmDownstream_rxMer2ndPercentile(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _TsOfdmDownstream_profileStats(ctx context.Context, field graphql.CollectedField, obj *model.TsOfdmDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsOfdmDownstream_profileStats(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsOfdmDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsOfdmDownstream_profileStats(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -5200,9 +5658,9 @@ func (ec *executionContext) _TsOfdmDownstream_profileStats(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsCmOfdmChannelProfileStats)
+	res := resTmp.([]*cablemodems1.TsCmOfdmChannelProfileStats)
 	fc.Result = res
-	return ec.marshalOTsCmOfdmChannelProfileStats2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelProfileStats(ctx, field.Selections, res)
+	return ec.marshalOTsCmOfdmChannelProfileStats2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelProfileStats(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TsOfdmDownstream_profileStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -5228,7 +5686,7 @@ func (ec *executionContext) fieldContext_TsOfdmDownstream_profileStats(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _TsOfdmDownstream_ofdmDsChannelPower(ctx context.Context, field graphql.CollectedField, obj *model.TsOfdmDownstream) (ret graphql.Marshaler) {
+func (ec *executionContext) _TsOfdmDownstream_ofdmDsChannelPower(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsOfdmDownstream) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_TsOfdmDownstream_ofdmDsChannelPower(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -5251,9 +5709,9 @@ func (ec *executionContext) _TsOfdmDownstream_ofdmDsChannelPower(ctx context.Con
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TsCmOfdmChannelPower)
+	res := resTmp.([]*cablemodems1.TsCmOfdmChannelPower)
 	fc.Result = res
-	return ec.marshalOTsCmOfdmChannelPower2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelPower(ctx, field.Selections, res)
+	return ec.marshalOTsCmOfdmChannelPower2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelPower(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_TsOfdmDownstream_ofdmDsChannelPower(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -5263,104 +5721,22 @@ func (ec *executionContext) fieldContext_TsOfdmDownstream_ofdmDsChannelPower(_ c
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "channelBandIndex":
-				return ec.fieldContext_TsCmOfdmChannelPower_channelBandIndex(ctx, field)
-			case "centerFrequency":
-				return ec.fieldContext_TsCmOfdmChannelPower_centerFrequency(ctx, field)
-			case "rxPower":
-				return ec.fieldContext_TsCmOfdmChannelPower_rxPower(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TsCmOfdmChannelPower", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _TsRegStateDevice_mac(ctx context.Context, field graphql.CollectedField, obj *model.TsRegStateDevice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TsRegStateDevice_mac(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Mac, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_TsRegStateDevice_mac(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "TsRegStateDevice",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _TsRegStateDevice_time(ctx context.Context, field graphql.CollectedField, obj *model.TsRegStateDevice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TsRegStateDevice_time(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*int32)
-	fc.Result = res
-	return ec.marshalOInt2ᚖint32(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_TsRegStateDevice_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "TsRegStateDevice",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "channelBandIndex":
+				return ec.fieldContext_TsCmOfdmChannelPower_channelBandIndex(ctx, field)
+			case "centerFrequency":
+				return ec.fieldContext_TsCmOfdmChannelPower_centerFrequency(ctx, field)
+			case "rxPower":
+				return ec.fieldContext_TsCmOfdmChannelPower_rxPower(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TsCmOfdmChannelPower", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TsRegStateDevice_regState(ctx context.Context, field graphql.CollectedField, obj *model.TsRegStateDevice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TsRegStateDevice_regState(ctx, field)
+func (ec *executionContext) _TsRegStateDevice_mac(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsRegStateDevice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TsRegStateDevice_mac(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5373,7 +5749,7 @@ func (ec *executionContext) _TsRegStateDevice_regState(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RegState, nil
+		return obj.Mac, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5382,26 +5758,26 @@ func (ec *executionContext) _TsRegStateDevice_regState(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int32)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint32(ctx, field.Selections, res)
+	return ec.marshalOMacAddress2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TsRegStateDevice_regState(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TsRegStateDevice_mac(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "TsRegStateDevice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type MacAddress does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_id(ctx, field)
+func (ec *executionContext) _TsRegStateDevice_time(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsRegStateDevice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TsRegStateDevice_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5414,38 +5790,35 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int32)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint32(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TsRegStateDevice_time(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "TsRegStateDevice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_User_name(ctx, field)
+func (ec *executionContext) _TsRegStateDevice_regState(ctx context.Context, field graphql.CollectedField, obj *cablemodems1.TsRegStateDevice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TsRegStateDevice_regState(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5458,31 +5831,28 @@ func (ec *executionContext) _User_name(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.RegState, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int32)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint32(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_User_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_TsRegStateDevice_regState(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "TsRegStateDevice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
@@ -7388,57 +7758,314 @@ func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field
 			case "isOneOf":
 				return ec.fieldContext___Type_isOneOf(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_isOneOf(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_isOneOf(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsOneOf(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputCableModemAttributes(ctx context.Context, obj any) (model.CableModemAttributes, error) {
+	var it model.CableModemAttributes
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"cmtsType", "deviceType", "isCPE"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "cmtsType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cmtsType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CmtsType = data
+		case "deviceType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deviceType"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DeviceType = data
+		case "isCPE":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isCPE"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsCpe = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCableModemInput(ctx context.Context, obj any) (model.CableModemInput, error) {
+	var it model.CableModemInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"mac", "cpeMac", "macDomain", "cableModemIndex", "configFile", "model", "fiberNode", "ipv4", "ipv6", "cpeIpv4", "transponder", "docsisVersion", "ppod", "fqdn", "state", "notFoundDate", "regState", "fnName", "numberOfGenerators", "rpdName", "bootr", "vendor", "swRev", "oltName", "ponName", "isCPE", "cmtsType", "deviceType"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "mac":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mac"))
+			data, err := ec.unmarshalNMacAddress2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Mac = data
+		case "cpeMac":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cpeMac"))
+			data, err := ec.unmarshalOMacAddress2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CpeMac = data
+		case "macDomain":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("macDomain"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MacDomain = data
+		case "cableModemIndex":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cableModemIndex"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CableModemIndex = data
+		case "configFile":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configFile"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ConfigFile = data
+		case "model":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("model"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Model = data
+		case "fiberNode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fiberNode"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FiberNode = data
+		case "ipv4":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ipv4"))
+			data, err := ec.unmarshalOIPv42ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ipv4 = data
+		case "ipv6":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ipv6"))
+			data, err := ec.unmarshalOIPv62ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ipv6 = data
+		case "cpeIpv4":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cpeIpv4"))
+			data, err := ec.unmarshalOIPv42ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CpeIpv4 = data
+		case "transponder":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("transponder"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Transponder = data
+		case "docsisVersion":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("docsisVersion"))
+			data, err := ec.unmarshalODocsisVersion2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐDocsisVersion(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DocsisVersion = data
+		case "ppod":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ppod"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Ppod = data
+		case "fqdn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fqdn"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Fqdn = data
+		case "state":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			data, err := ec.unmarshalOState2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐState(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.State = data
+		case "notFoundDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notFoundDate"))
+			data, err := ec.unmarshalODate2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NotFoundDate = data
+		case "regState":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("regState"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RegState = data
+		case "fnName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fnName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FnName = data
+		case "numberOfGenerators":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("numberOfGenerators"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NumberOfGenerators = data
+		case "rpdName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rpdName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RpdName = data
+		case "bootr":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bootr"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Bootr = data
+		case "vendor":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vendor"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Vendor = data
+		case "swRev":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("swRev"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SwRev = data
+		case "oltName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("oltName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OltName = data
+		case "ponName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ponName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PonName = data
+		case "isCPE":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isCPE"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsCpe = data
+		case "cmtsType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cmtsType"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CmtsType = data
+		case "deviceType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deviceType"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DeviceType = data
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsOneOf(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
 	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+	return it, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
 func (ec *executionContext) unmarshalInputCableModemsFilter(ctx context.Context, obj any) (model.CableModemsFilter, error) {
 	var it model.CableModemsFilter
 	asMap := map[string]any{}
@@ -7504,7 +8131,7 @@ func (ec *executionContext) unmarshalInputCableModemsFilter(ctx context.Context,
 			it.MacDomain = data
 		case "macAddress":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("macAddress"))
-			data, err := ec.unmarshalOStringFilterEqIn2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐStringFilterEqIn(ctx, v)
+			data, err := ec.unmarshalOMacAddressFilterEqIn2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐMacAddressFilterEqIn(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -7515,42 +8142,8 @@ func (ec *executionContext) unmarshalInputCableModemsFilter(ctx context.Context,
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputNewTodo(ctx context.Context, obj any) (model.NewTodo, error) {
-	var it model.NewTodo
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"text", "userId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "text":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("text"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Text = data
-		case "userId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.UserID = data
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputStringFilterEqIn(ctx context.Context, obj any) (model.StringFilterEqIn, error) {
-	var it model.StringFilterEqIn
+func (ec *executionContext) unmarshalInputMacAddressFilterEqIn(ctx context.Context, obj any) (model.MacAddressFilterEqIn, error) {
+	var it model.MacAddressFilterEqIn
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
@@ -7565,14 +8158,14 @@ func (ec *executionContext) unmarshalInputStringFilterEqIn(ctx context.Context,
 		switch k {
 		case "eq":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			data, err := ec.unmarshalOMacAddress2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Eq = data
 		case "in":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("in"))
-			data, err := ec.unmarshalOString2ᚕᚖstring(ctx, v)
+			data, err := ec.unmarshalOMacAddress2ᚕᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -7945,8 +8538,186 @@ func (ec *executionContext) _CableModemsConnection(ctx context.Context, sel ast.
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageInfo":
-			out.Values[i] = ec._CableModemsConnection_pageInfo(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._CableModemsConnection_pageInfo(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cableModemsMutationsImplementors = []string{"CableModemsMutations"}
+
+func (ec *executionContext) _CableModemsMutations(ctx context.Context, sel ast.SelectionSet, obj *cablemodems.CableModemsMutations) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cableModemsMutationsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CableModemsMutations")
+		case "upsert":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CableModemsMutations_upsert(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "markNotFound":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CableModemsMutations_markNotFound(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "restore":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CableModemsMutations_restore(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "update":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CableModemsMutations_update(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7989,9 +8760,9 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createTodo":
+		case "cableModems":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createTodo(ctx, field)
+				return ec._Mutation_cableModems(ctx, field)
 			})
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
@@ -8135,63 +8906,9 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var todoImplementors = []string{"Todo"}
-
-func (ec *executionContext) _Todo(ctx context.Context, sel ast.SelectionSet, obj *model.Todo) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, todoImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Todo")
-		case "id":
-			out.Values[i] = ec._Todo_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "text":
-			out.Values[i] = ec._Todo_text(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "done":
-			out.Values[i] = ec._Todo_done(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "user":
-			out.Values[i] = ec._Todo_user(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
 var tsCableDownstreamImplementors = []string{"TsCableDownstream"}
 
-func (ec *executionContext) _TsCableDownstream(ctx context.Context, sel ast.SelectionSet, obj *model.TsCableDownstream) graphql.Marshaler {
+func (ec *executionContext) _TsCableDownstream(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsCableDownstream) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsCableDownstreamImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8237,7 +8954,7 @@ func (ec *executionContext) _TsCableDownstream(ctx context.Context, sel ast.Sele
 
 var tsCableUpstreamImplementors = []string{"TsCableUpstream"}
 
-func (ec *executionContext) _TsCableUpstream(ctx context.Context, sel ast.SelectionSet, obj *model.TsCableUpstream) graphql.Marshaler {
+func (ec *executionContext) _TsCableUpstream(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsCableUpstream) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsCableUpstreamImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8279,7 +8996,7 @@ func (ec *executionContext) _TsCableUpstream(ctx context.Context, sel ast.Select
 
 var tsCableUpstreamStatusImplementors = []string{"TsCableUpstreamStatus"}
 
-func (ec *executionContext) _TsCableUpstreamStatus(ctx context.Context, sel ast.SelectionSet, obj *model.TsCableUpstreamStatus) graphql.Marshaler {
+func (ec *executionContext) _TsCableUpstreamStatus(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsCableUpstreamStatus) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsCableUpstreamStatusImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8319,7 +9036,7 @@ func (ec *executionContext) _TsCableUpstreamStatus(ctx context.Context, sel ast.
 
 var tsCmDeviceImplementors = []string{"TsCmDevice"}
 
-func (ec *executionContext) _TsCmDevice(ctx context.Context, sel ast.SelectionSet, obj *model.TsCmDevice) graphql.Marshaler {
+func (ec *executionContext) _TsCmDevice(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsCmDevice) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsCmDeviceImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8369,7 +9086,7 @@ func (ec *executionContext) _TsCmDevice(ctx context.Context, sel ast.SelectionSe
 
 var tsCmOfdmChannelPowerImplementors = []string{"TsCmOfdmChannelPower"}
 
-func (ec *executionContext) _TsCmOfdmChannelPower(ctx context.Context, sel ast.SelectionSet, obj *model.TsCmOfdmChannelPower) graphql.Marshaler {
+func (ec *executionContext) _TsCmOfdmChannelPower(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsCmOfdmChannelPower) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsCmOfdmChannelPowerImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8409,7 +9126,7 @@ func (ec *executionContext) _TsCmOfdmChannelPower(ctx context.Context, sel ast.S
 
 var tsCmOfdmChannelProfileStatsImplementors = []string{"TsCmOfdmChannelProfileStats"}
 
-func (ec *executionContext) _TsCmOfdmChannelProfileStats(ctx context.Context, sel ast.SelectionSet, obj *model.TsCmOfdmChannelProfileStats) graphql.Marshaler {
+func (ec *executionContext) _TsCmOfdmChannelProfileStats(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsCmOfdmChannelProfileStats) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsCmOfdmChannelProfileStatsImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8451,7 +9168,7 @@ func (ec *executionContext) _TsCmOfdmChannelProfileStats(ctx context.Context, se
 
 var tsOfdmDownstreamImplementors = []string{"TsOfdmDownstream"}
 
-func (ec *executionContext) _TsOfdmDownstream(ctx context.Context, sel ast.SelectionSet, obj *model.TsOfdmDownstream) graphql.Marshaler {
+func (ec *executionContext) _TsOfdmDownstream(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsOfdmDownstream) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsOfdmDownstreamImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8495,7 +9212,7 @@ func (ec *executionContext) _TsOfdmDownstream(ctx context.Context, sel ast.Selec
 
 var tsRegStateDeviceImplementors = []string{"TsRegStateDevice"}
 
-func (ec *executionContext) _TsRegStateDevice(ctx context.Context, sel ast.SelectionSet, obj *model.TsRegStateDevice) graphql.Marshaler {
+func (ec *executionContext) _TsRegStateDevice(ctx context.Context, sel ast.SelectionSet, obj *cablemodems1.TsRegStateDevice) graphql.Marshaler {
 	fields := graphql.CollectFields(ec.OperationContext, sel, tsRegStateDeviceImplementors)
 
 	out := graphql.NewFieldSet(fields)
@@ -8533,50 +9250,6 @@ func (ec *executionContext) _TsRegStateDevice(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var userImplementors = []string{"User"}
-
-func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("User")
-		case "id":
-			out.Values[i] = ec._User_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._User_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -8982,6 +9655,31 @@ func (ec *executionContext) marshalNCableModem2ᚖapiᚑprojectᚋgraphqlᚑapi
 	return ec._CableModem(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNCableModemAttributes2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemAttributes(ctx context.Context, v any) (model.CableModemAttributes, error) {
+	res, err := ec.unmarshalInputCableModemAttributes(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCableModemInput2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemInputᚄ(ctx context.Context, v any) ([]*model.CableModemInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.CableModemInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCableModemInput2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNCableModemInput2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐCableModemInput(ctx context.Context, v any) (*model.CableModemInput, error) {
+	res, err := ec.unmarshalInputCableModemInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNCableModems2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋcablemodemsᚐCableModems(ctx context.Context, sel ast.SelectionSet, v cablemodems.CableModems) graphql.Marshaler {
 	return ec._CableModems(ctx, sel, &v)
 }
@@ -8996,55 +9694,38 @@ func (ec *executionContext) marshalNCableModems2ᚖapiᚑprojectᚋgraphqlᚑapi
 	return ec._CableModems(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNHistoricalPeriod2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐHistoricalPeriod(ctx context.Context, v any) (model.HistoricalPeriod, error) {
-	var res model.HistoricalPeriod
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNHistoricalPeriod2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐHistoricalPeriod(ctx context.Context, sel ast.SelectionSet, v model.HistoricalPeriod) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNCableModemsMutations2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋcablemodemsᚐCableModemsMutations(ctx context.Context, sel ast.SelectionSet, v cablemodems.CableModemsMutations) graphql.Marshaler {
+	return ec._CableModemsMutations(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNCableModemsMutations2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋcablemodemsᚐCableModemsMutations(ctx context.Context, sel ast.SelectionSet, v *cablemodems.CableModemsMutations) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNNewTodo2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐNewTodo(ctx context.Context, v any) (model.NewTodo, error) {
-	res, err := ec.unmarshalInputNewTodo(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._CableModemsMutations(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNPollerType2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐPollerType(ctx context.Context, v any) (model.PollerType, error) {
-	var res model.PollerType
+func (ec *executionContext) unmarshalNHistoricalPeriod2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐHistoricalPeriod(ctx context.Context, v any) (model.HistoricalPeriod, error) {
+	var res model.HistoricalPeriod
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNPollerType2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐPollerType(ctx context.Context, sel ast.SelectionSet, v model.PollerType) graphql.Marshaler {
+func (ec *executionContext) marshalNHistoricalPeriod2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐHistoricalPeriod(ctx context.Context, sel ast.SelectionSet, v model.HistoricalPeriod) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalNMacAddress2string(ctx context.Context, v any) (string, error) {
+	res, err := model.UnmarshalMacAddress(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+func (ec *executionContext) marshalNMacAddress2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalString(v)
+	res := model.MarshalMacAddress(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -9053,14 +9734,14 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
-func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+func (ec *executionContext) unmarshalNMacAddress2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	var vSlice []any
 	vSlice = graphql.CoerceList(v)
 	var err error
 	res := make([]string, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNMacAddress2string(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -9068,10 +9749,10 @@ func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v
 	return res, nil
 }
 
-func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalNMacAddress2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	for i := range v {
-		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+		ret[i] = ec.marshalNMacAddress2string(ctx, sel, v[i])
 	}
 
 	for _, e := range ret {
@@ -9083,48 +9764,50 @@ func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel
 	return ret
 }
 
-func (ec *executionContext) marshalNTodo2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTodo(ctx context.Context, sel ast.SelectionSet, v model.Todo) graphql.Marshaler {
-	return ec._Todo(ctx, sel, &v)
+func (ec *executionContext) unmarshalNPollerType2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐPollerType(ctx context.Context, v any) (model.PollerType, error) {
+	var res model.PollerType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNTodo2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTodo(ctx context.Context, sel ast.SelectionSet, v *model.Todo) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Todo(ctx, sel, v)
+func (ec *executionContext) marshalNPollerType2apiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐPollerType(ctx context.Context, sel ast.SelectionSet, v model.PollerType) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNTsCmDevice2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmDevice(ctx context.Context, sel ast.SelectionSet, v *model.TsCmDevice) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-		return graphql.Null
 	}
-	return ec._TsCmDevice(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) marshalNTsRegStateDevice2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsRegStateDevice(ctx context.Context, sel ast.SelectionSet, v *model.TsRegStateDevice) graphql.Marshaler {
+func (ec *executionContext) marshalNTsCmDevice2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmDevice(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsCmDevice) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._TsRegStateDevice(ctx, sel, v)
+	return ec._TsCmDevice(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNUser2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
+func (ec *executionContext) marshalNTsRegStateDevice2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsRegStateDevice(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsRegStateDevice) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._User(ctx, sel, v)
+	return ec._TsRegStateDevice(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
@@ -9425,6 +10108,24 @@ func (ec *executionContext) unmarshalOCableModemsFilter2ᚖapiᚑprojectᚋgraph
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalODate2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := model.UnmarshalDate(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODate2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := model.MarshalDate(*v)
+	return res
+}
+
 func (ec *executionContext) unmarshalODocsisVersion2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐDocsisVersion(ctx context.Context, v any) (*model.DocsisVersion, error) {
 	if v == nil {
 		return nil, nil
@@ -9441,48 +10142,61 @@ func (ec *executionContext) marshalODocsisVersion2ᚖapiᚑprojectᚋgraphqlᚑa
 	return v
 }
 
-func (ec *executionContext) unmarshalOInt2ᚖint32(ctx context.Context, v any) (*int32, error) {
+func (ec *executionContext) unmarshalOIPv42ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalInt32(v)
+	res, err := model.UnmarshalIPv4(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOInt2ᚖint32(ctx context.Context, sel ast.SelectionSet, v *int32) graphql.Marshaler {
+func (ec *executionContext) marshalOIPv42ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	_ = sel
 	_ = ctx
-	res := graphql.MarshalInt32(*v)
+	res := model.MarshalIPv4(*v)
 	return res
 }
 
-func (ec *executionContext) marshalOPageInfo2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+func (ec *executionContext) unmarshalOIPv62ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := model.UnmarshalIPv6(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOIPv62ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._PageInfo(ctx, sel, v)
+	_ = sel
+	_ = ctx
+	res := model.MarshalIPv6(*v)
+	return res
 }
 
-func (ec *executionContext) unmarshalOState2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐState(ctx context.Context, v any) (*model.State, error) {
+func (ec *executionContext) unmarshalOInt2ᚖint32(ctx context.Context, v any) (*int32, error) {
 	if v == nil {
 		return nil, nil
 	}
-	var res = new(model.State)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	res, err := graphql.UnmarshalInt32(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOState2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐState(ctx context.Context, sel ast.SelectionSet, v *model.State) graphql.Marshaler {
+func (ec *executionContext) marshalOInt2ᚖint32(ctx context.Context, sel ast.SelectionSet, v *int32) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt32(*v)
+	return res
 }
 
-func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+func (ec *executionContext) unmarshalOMacAddress2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
 	if v == nil {
 		return nil, nil
 	}
@@ -9492,7 +10206,7 @@ func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v
 	res := make([]string, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNMacAddress2string(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -9500,13 +10214,13 @@ func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v
 	return res, nil
 }
 
-func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalOMacAddress2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	ret := make(graphql.Array, len(v))
 	for i := range v {
-		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+		ret[i] = ec.marshalNMacAddress2string(ctx, sel, v[i])
 	}
 
 	for _, e := range ret {
@@ -9518,7 +10232,7 @@ func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel
 	return ret
 }
 
-func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v any) ([]*string, error) {
+func (ec *executionContext) unmarshalOMacAddress2ᚕᚖstring(ctx context.Context, v any) ([]*string, error) {
 	if v == nil {
 		return nil, nil
 	}
@@ -9528,7 +10242,7 @@ func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v
 	res := make([]*string, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalOString2ᚖstring(ctx, vSlice[i])
+		res[i], err = ec.unmarshalOMacAddress2ᚖstring(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -9536,18 +10250,67 @@ func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v
 	return res, nil
 }
 
-func (ec *executionContext) marshalOString2ᚕᚖstring(ctx context.Context, sel ast.SelectionSet, v []*string) graphql.Marshaler {
+func (ec *executionContext) marshalOMacAddress2ᚕᚖstring(ctx context.Context, sel ast.SelectionSet, v []*string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	ret := make(graphql.Array, len(v))
 	for i := range v {
-		ret[i] = ec.marshalOString2ᚖstring(ctx, sel, v[i])
+		ret[i] = ec.marshalOMacAddress2ᚖstring(ctx, sel, v[i])
 	}
 
 	return ret
 }
 
+func (ec *executionContext) unmarshalOMacAddress2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := model.UnmarshalMacAddress(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOMacAddress2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := model.MarshalMacAddress(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOMacAddressFilterEqIn2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐMacAddressFilterEqIn(ctx context.Context, v any) (*model.MacAddressFilterEqIn, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputMacAddressFilterEqIn(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOPageInfo2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOState2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐState(ctx context.Context, v any) (*model.State, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.State)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOState2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐState(ctx context.Context, sel ast.SelectionSet, v *model.State) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -9566,15 +10329,25 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
-func (ec *executionContext) unmarshalOStringFilterEqIn2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐStringFilterEqIn(ctx context.Context, v any) (*model.StringFilterEqIn, error) {
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := ec.unmarshalInputStringFilterEqIn(ctx, v)
+	res, err := model.UnmarshalTime(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOTsCableDownstream2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableDownstream(ctx context.Context, sel ast.SelectionSet, v []*model.TsCableDownstream) graphql.Marshaler {
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := model.MarshalTime(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOTsCableDownstream2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableDownstream(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsCableDownstream) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9601,7 +10374,7 @@ func (ec *executionContext) marshalOTsCableDownstream2ᚕᚖapiᚑprojectᚋgrap
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOTsCableDownstream2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableDownstream(ctx, sel, v[i])
+			ret[i] = ec.marshalOTsCableDownstream2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableDownstream(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9615,14 +10388,14 @@ func (ec *executionContext) marshalOTsCableDownstream2ᚕᚖapiᚑprojectᚋgrap
 	return ret
 }
 
-func (ec *executionContext) marshalOTsCableDownstream2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableDownstream(ctx context.Context, sel ast.SelectionSet, v *model.TsCableDownstream) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCableDownstream2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableDownstream(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsCableDownstream) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._TsCableDownstream(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOTsCableUpstream2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstream(ctx context.Context, sel ast.SelectionSet, v []*model.TsCableUpstream) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCableUpstream2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstream(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsCableUpstream) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9649,7 +10422,7 @@ func (ec *executionContext) marshalOTsCableUpstream2ᚕᚖapiᚑprojectᚋgraphq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOTsCableUpstream2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstream(ctx, sel, v[i])
+			ret[i] = ec.marshalOTsCableUpstream2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstream(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9663,14 +10436,14 @@ func (ec *executionContext) marshalOTsCableUpstream2ᚕᚖapiᚑprojectᚋgraphq
 	return ret
 }
 
-func (ec *executionContext) marshalOTsCableUpstream2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstream(ctx context.Context, sel ast.SelectionSet, v *model.TsCableUpstream) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCableUpstream2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstream(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsCableUpstream) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._TsCableUpstream(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOTsCableUpstreamStatus2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstreamStatus(ctx context.Context, sel ast.SelectionSet, v []*model.TsCableUpstreamStatus) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCableUpstreamStatus2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstreamStatus(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsCableUpstreamStatus) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9697,7 +10470,7 @@ func (ec *executionContext) marshalOTsCableUpstreamStatus2ᚕᚖapiᚑprojectᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOTsCableUpstreamStatus2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstreamStatus(ctx, sel, v[i])
+			ret[i] = ec.marshalOTsCableUpstreamStatus2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstreamStatus(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9711,14 +10484,14 @@ func (ec *executionContext) marshalOTsCableUpstreamStatus2ᚕᚖapiᚑprojectᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalOTsCableUpstreamStatus2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCableUpstreamStatus(ctx context.Context, sel ast.SelectionSet, v *model.TsCableUpstreamStatus) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCableUpstreamStatus2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCableUpstreamStatus(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsCableUpstreamStatus) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._TsCableUpstreamStatus(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOTsCmDevice2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmDeviceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TsCmDevice) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCmDevice2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmDeviceᚄ(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsCmDevice) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9745,7 +10518,7 @@ func (ec *executionContext) marshalOTsCmDevice2ᚕᚖapiᚑprojectᚋgraphqlᚑa
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNTsCmDevice2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmDevice(ctx, sel, v[i])
+			ret[i] = ec.marshalNTsCmDevice2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmDevice(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9765,7 +10538,7 @@ func (ec *executionContext) marshalOTsCmDevice2ᚕᚖapiᚑprojectᚋgraphqlᚑa
 	return ret
 }
 
-func (ec *executionContext) marshalOTsCmOfdmChannelPower2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelPower(ctx context.Context, sel ast.SelectionSet, v []*model.TsCmOfdmChannelPower) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCmOfdmChannelPower2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelPower(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsCmOfdmChannelPower) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9792,7 +10565,7 @@ func (ec *executionContext) marshalOTsCmOfdmChannelPower2ᚕᚖapiᚑprojectᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOTsCmOfdmChannelPower2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelPower(ctx, sel, v[i])
+			ret[i] = ec.marshalOTsCmOfdmChannelPower2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelPower(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9806,14 +10579,14 @@ func (ec *executionContext) marshalOTsCmOfdmChannelPower2ᚕᚖapiᚑprojectᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalOTsCmOfdmChannelPower2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelPower(ctx context.Context, sel ast.SelectionSet, v *model.TsCmOfdmChannelPower) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCmOfdmChannelPower2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelPower(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsCmOfdmChannelPower) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._TsCmOfdmChannelPower(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOTsCmOfdmChannelProfileStats2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelProfileStats(ctx context.Context, sel ast.SelectionSet, v []*model.TsCmOfdmChannelProfileStats) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCmOfdmChannelProfileStats2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelProfileStats(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsCmOfdmChannelProfileStats) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9840,7 +10613,7 @@ func (ec *executionContext) marshalOTsCmOfdmChannelProfileStats2ᚕᚖapiᚑproj
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOTsCmOfdmChannelProfileStats2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelProfileStats(ctx, sel, v[i])
+			ret[i] = ec.marshalOTsCmOfdmChannelProfileStats2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelProfileStats(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9854,14 +10627,14 @@ func (ec *executionContext) marshalOTsCmOfdmChannelProfileStats2ᚕᚖapiᚑproj
 	return ret
 }
 
-func (ec *executionContext) marshalOTsCmOfdmChannelProfileStats2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsCmOfdmChannelProfileStats(ctx context.Context, sel ast.SelectionSet, v *model.TsCmOfdmChannelProfileStats) graphql.Marshaler {
+func (ec *executionContext) marshalOTsCmOfdmChannelProfileStats2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsCmOfdmChannelProfileStats(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsCmOfdmChannelProfileStats) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._TsCmOfdmChannelProfileStats(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOTsOfdmDownstream2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsOfdmDownstream(ctx context.Context, sel ast.SelectionSet, v []*model.TsOfdmDownstream) graphql.Marshaler {
+func (ec *executionContext) marshalOTsOfdmDownstream2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsOfdmDownstream(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsOfdmDownstream) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9888,7 +10661,7 @@ func (ec *executionContext) marshalOTsOfdmDownstream2ᚕᚖapiᚑprojectᚋgraph
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOTsOfdmDownstream2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsOfdmDownstream(ctx, sel, v[i])
+			ret[i] = ec.marshalOTsOfdmDownstream2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsOfdmDownstream(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9902,14 +10675,14 @@ func (ec *executionContext) marshalOTsOfdmDownstream2ᚕᚖapiᚑprojectᚋgraph
 	return ret
 }
 
-func (ec *executionContext) marshalOTsOfdmDownstream2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsOfdmDownstream(ctx context.Context, sel ast.SelectionSet, v *model.TsOfdmDownstream) graphql.Marshaler {
+func (ec *executionContext) marshalOTsOfdmDownstream2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsOfdmDownstream(ctx context.Context, sel ast.SelectionSet, v *cablemodems1.TsOfdmDownstream) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._TsOfdmDownstream(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOTsRegStateDevice2ᚕᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsRegStateDeviceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TsRegStateDevice) graphql.Marshaler {
+func (ec *executionContext) marshalOTsRegStateDevice2ᚕᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsRegStateDeviceᚄ(ctx context.Context, sel ast.SelectionSet, v []*cablemodems1.TsRegStateDevice) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9936,7 +10709,7 @@ func (ec *executionContext) marshalOTsRegStateDevice2ᚕᚖapiᚑprojectᚋgraph
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNTsRegStateDevice2ᚖapiᚑprojectᚋgraphqlᚑapiᚋgqlᚋgraphᚋmodelᚐTsRegStateDevice(ctx, sel, v[i])
+			ret[i] = ec.marshalNTsRegStateDevice2ᚖapiᚑprojectᚋpkgᚋcablemodemsᚐTsRegStateDevice(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8,5 +8,6 @@ import "api-project/graphql-api/gql/graph/cablemodems"
 // so we allocate exactly once at program start, here.
 // they can't be next to where they're used because gqlgen generate will move them out of the resolver files.
 var (
-	stubModemsResolver = new(cablemodems.CableModems)
+	stubModemsResolver         = new(cablemodems.CableModems)
+	stubModemsMutationResolver = new(cablemodems.CableModemsMutations)
 )
@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"api-project/pkg/cablemodems"
+	"api-project/pkg/sqltest"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestHostileArgumentsAreBound(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name  string
+		query string
+		// mac arguments are rejected as they are parsed, before any query runs.
+		mac bool
+	}{
+		{"byMac", `query($v: MacAddress!) { cableModems { byMac(macAddress: [$v]) { mac } } }`, true},
+		{"byCmts", `query($v: String!) { cableModems { byCmts(cmts: $v) { mac } } }`, false},
+		{"byCmts single", `query($v: String!) { cableModems { byCmts(cmts: $v, single: true) { mac } } }`, false},
+		{"byPoller", `query($v: String!) { cableModems { byPoller(poller: RX_MER, cmts: $v) { mac } } }`, false},
+		{"paged fqdn", `query($v: String!) { cableModems { paged(filter: {fqdn: $v, ppod: $v}) { pageInfo { hasNextPage } } } }`, false},
+		{"paged mac", `query($v: MacAddress!) { cableModems { paged(filter: {macAddress: {in: [$v, $v]}}) { pageInfo { hasNextPage } } } }`, true},
+		{"paged mac domain", `query($v: String!) { cableModems { paged(filter: {macDomain: $v, dsInterface: $v, fiberNode: $v}) { pageInfo { hasNextPage } } } }`, false},
+		{"historicalRegState", `query($v: MacAddress!) { cableModems { historicalRegState(mac: [$v], period: Hourly) { mac } } }`, true},
+		{"historicalCm", `query($v: MacAddress!) { cableModems { historicalCm(mac: [$v]) { mac } } }`, true},
+		{"upsert", `mutation($v: String!) { cableModems { upsert(modems: [{mac: "5c:22:da:0e:9f:01", fqdn: $v, model: $v}]) { mac } } }`, false},
+		{"update", `mutation($v: String!) { cableModems { update(macAddress: ["5c:22:da:0e:9f:01"], set: {cmtsType: $v}) { mac } } }`, false},
+		{"markNotFound", `mutation($v: MacAddress!) { cableModems { markNotFound(macAddress: [$v]) { mac } } }`, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range sqltest.Hostile {
+				rec := sqltest.New(t)
+				srv := handler.New(NewExecutableSchema(Config{Resolvers: &Resolver{Repo: cablemodems.NewPostgres(rec)}}))
+				srv.AddTransport(transport.POST{})
+
+				var resp map[string]interface{}
+				err := client.New(srv).Post(tt.query, &resp, client.Var("v", v))
+				if tt.mac {
+					if err == nil || len(rec.Statements()) != 0 {
+						t.Errorf("%q: got %v, want a validation error without touching the database", v, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("%q: %v", v, err)
+					continue
+				}
+				rec.AssertBound(t, v)
+			}
+		})
+	}
+}
+
+func TestByMacBindsOneArray(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: &Resolver{Repo: cablemodems.NewPostgres(rec)}}))
+	srv.AddTransport(transport.POST{})
+	macs := make([]string, 70_000)
+	for i := range macs {
+		macs[i] = fmt.Sprintf("5c22da%06x", i)
+	}
+	var resp map[string]interface{}
+	if err := client.New(srv).Post(`query($macs: [MacAddress!]!) { cableModems { byMac(macAddress: $macs) { mac } } }`, &resp, client.Var("macs", macs)); err != nil {
+		t.Fatal(err)
+	}
+	if statements := rec.Statements(); len(statements) != 1 || !strings.Contains(statements[0].Query, "mac = ANY($1)") {
+		t.Errorf("got %d statements, want one binding every mac as an array", len(statements))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type CableModem struct {
@@ -30,7 +31,7 @@ type CableModem struct {
 	FnName             *string        `json:"fnName,omitempty"`
 	NumberOfGenerators *int32         `json:"numberOfGenerators,omitempty"`
 	RpdName            *string        `json:"rpdName,omitempty"`
-	UpdatedAt          *string        `json:"updatedAt,omitempty"`
+	UpdatedAt          *time.Time     `json:"updatedAt,omitempty"`
 	Bootr              *string        `json:"bootr,omitempty"`
 	Vendor             *string        `json:"vendor,omitempty"`
 	SwRev              *string        `json:"swRev,omitempty"`
@@ -43,28 +44,67 @@ type CableModem struct {
 	DeviceType *int32 `json:"deviceType,omitempty"`
 }
 
+// The attributes update may set. Null or missing attributes are left alone.
+type CableModemAttributes struct {
+	CmtsType   *string `json:"cmtsType,omitempty"`
+	DeviceType *int32  `json:"deviceType,omitempty"`
+	IsCpe      *bool   `json:"isCPE,omitempty"`
+}
+
+// A whole modem record. The update times are set by the server.
+type CableModemInput struct {
+	Mac                string         `json:"mac"`
+	CpeMac             *string        `json:"cpeMac,omitempty"`
+	MacDomain          *string        `json:"macDomain,omitempty"`
+	CableModemIndex    *int32         `json:"cableModemIndex,omitempty"`
+	ConfigFile         *string        `json:"configFile,omitempty"`
+	Model              *string        `json:"model,omitempty"`
+	FiberNode          *string        `json:"fiberNode,omitempty"`
+	Ipv4               *string        `json:"ipv4,omitempty"`
+	Ipv6               *string        `json:"ipv6,omitempty"`
+	CpeIpv4            *string        `json:"cpeIpv4,omitempty"`
+	Transponder        *string        `json:"transponder,omitempty"`
+	DocsisVersion      *DocsisVersion `json:"docsisVersion,omitempty"`
+	Ppod               *string        `json:"ppod,omitempty"`
+	Fqdn               *string        `json:"fqdn,omitempty"`
+	State              *State         `json:"state,omitempty"`
+	NotFoundDate       *string        `json:"notFoundDate,omitempty"`
+	RegState           *int32         `json:"regState,omitempty"`
+	FnName             *string        `json:"fnName,omitempty"`
+	NumberOfGenerators *int32         `json:"numberOfGenerators,omitempty"`
+	RpdName            *string        `json:"rpdName,omitempty"`
+	Bootr              *string        `json:"bootr,omitempty"`
+	Vendor             *string        `json:"vendor,omitempty"`
+	SwRev              *string        `json:"swRev,omitempty"`
+	OltName            *string        `json:"oltName,omitempty"`
+	PonName            *string        `json:"ponName,omitempty"`
+	IsCpe              *bool          `json:"isCPE,omitempty"`
+	CmtsType           *string        `json:"cmtsType,omitempty"`
+	DeviceType         *int32         `json:"deviceType,omitempty"`
+}
+
 type CableModemsConnection struct {
 	Edges    []*CableModem `json:"edges"`
 	PageInfo *PageInfo     `json:"pageInfo,omitempty"`
 }
 
 type CableModemsFilter struct {
-	DocsisVersion *DocsisVersion    `json:"docsisVersion,omitempty"`
-	DsInterface   *string           `json:"dsInterface,omitempty"`
-	Fqdn          *string           `json:"fqdn,omitempty"`
-	Ppod          *string           `json:"ppod,omitempty"`
-	FiberNode     *string           `json:"fiberNode,omitempty"`
-	Transponder   *bool             `json:"transponder,omitempty"`
-	MacDomain     *string           `json:"macDomain,omitempty"`
-	MacAddress    *StringFilterEqIn `json:"macAddress,omitempty"`
+	DocsisVersion *DocsisVersion        `json:"docsisVersion,omitempty"`
+	DsInterface   *string               `json:"dsInterface,omitempty"`
+	Fqdn          *string               `json:"fqdn,omitempty"`
+	Ppod          *string               `json:"ppod,omitempty"`
+	FiberNode     *string               `json:"fiberNode,omitempty"`
+	Transponder   *bool                 `json:"transponder,omitempty"`
+	MacDomain     *string               `json:"macDomain,omitempty"`
+	MacAddress    *MacAddressFilterEqIn `json:"macAddress,omitempty"`
 }
 
-type Mutation struct {
+type MacAddressFilterEqIn struct {
+	Eq *string   `json:"eq,omitempty"`
+	In []*string `json:"in,omitempty"`
 }
 
-type NewTodo struct {
-	Text   string `json:"text"`
-	UserID string `json:"userId"`
+type Mutation struct {
 }
 
 type PageInfo struct {
@@ -75,83 +115,6 @@ type PageInfo struct {
 type Query struct {
 }
 
-type StringFilterEqIn struct {
-	Eq *string   `json:"eq,omitempty"`
-	In []*string `json:"in,omitempty"`
-}
-
-type Todo struct {
-	ID   string `json:"id"`
-	Text string `json:"text"`
-	Done bool   `json:"done"`
-	User *User  `json:"user"`
-}
-
-type TsCableDownstream struct {
-	IfIndex            *int32  `json:"ifIndex,omitempty"`
-	ChannelPower       *string `json:"channelPower,omitempty"`
-	Unerroreds         *string `json:"unerroreds,omitempty"`
-	Correcteds         *string `json:"correcteds,omitempty"`
-	Uncorrectables     *string `json:"uncorrectables,omitempty"`
-	SignalNoiseDecibel *string `json:"signalNoiseDecibel,omitempty"`
-}
-
-type TsCableUpstream struct {
-	IfIndex          *int32  `json:"ifIndex,omitempty"`
-	StatusTxPower    *string `json:"statusTxPower,omitempty"`
-	StatusT3Timeouts *int32  `json:"statusT3Timeouts,omitempty"`
-	StatusT4Timeouts *int32  `json:"statusT4Timeouts,omitempty"`
-}
-
-type TsCableUpstreamStatus struct {
-	IfDescr *string `json:"ifDescr,omitempty"`
-	Snr     *string `json:"snr,omitempty"`
-	RxPower *string `json:"rxPower,omitempty"`
-}
-
-type TsCmDevice struct {
-	Mac                 *string                  `json:"mac,omitempty"`
-	Time                *int32                   `json:"time,omitempty"`
-	LostSync            *int32                   `json:"lostSync,omitempty"`
-	Resets              *int32                   `json:"resets,omitempty"`
-	CableDownstream     []*TsCableDownstream     `json:"cableDownstream,omitempty"`
-	CableUpstream       []*TsCableUpstream       `json:"cableUpstream,omitempty"`
-	CableUpstreamStatus []*TsCableUpstreamStatus `json:"cableUpstreamStatus,omitempty"`
-	OfdmDownstream      []*TsOfdmDownstream      `json:"ofdmDownstream,omitempty"`
-}
-
-type TsCmOfdmChannelPower struct {
-	ChannelBandIndex *int32  `json:"channelBandIndex,omitempty"`
-	CenterFrequency  *int32  `json:"centerFrequency,omitempty"`
-	RxPower          *string `json:"rxPower,omitempty"`
-}
-
-type TsCmOfdmChannelProfileStats struct {
-	CmtsProfileID         *int32  `json:"cmtsProfileId,omitempty"`
-	CorrectedCodewords    *string `json:"correctedCodewords,omitempty"`
-	UncorrectableCodeword *string `json:"uncorrectableCodeword,omitempty"`
-	TotalCodewords        *string `json:"totalCodewords,omitempty"`
-}
-
-type TsOfdmDownstream struct {
-	IfIndex            *int32                         `json:"ifIndex,omitempty"`
-	RxMerMean          *string                        `json:"rxMerMean,omitempty"`
-	RxMer2ndPercentile *string                        `json:"rxMer2ndPercentile,omitempty"`
-	ProfileStats       []*TsCmOfdmChannelProfileStats `json:"profileStats,omitempty"`
-	OfdmDsChannelPower []*TsCmOfdmChannelPower        `json:"ofdmDsChannelPower,omitempty"`
-}
-
-type TsRegStateDevice struct {
-	Mac      *string `json:"mac,omitempty"`
-	Time     *int32  `json:"time,omitempty"`
-	RegState *int32  `json:"regState,omitempty"`
-}
-
-type User struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
 type DocsisVersion string
 
 const (
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"api-project/pkg/mac"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// The scalars below are bound to plain Go values in gqlgen.yml, so the repository types are served without
+// conversion while arguments are validated before any resolver runs.
+
+// dateLayout is the YYYYMMDD form dates are stored and served in.
+const dateLayout = "20060102"
+
+// MarshalMacAddress serves a mac in the canonical form of package mac.
+func MarshalMacAddress(s string) graphql.Marshaler {
+	if a, err := mac.Parse(s); err == nil {
+		s = a.String()
+	}
+	return graphql.MarshalString(s)
+}
+
+// UnmarshalMacAddress accepts a mac in any common notation and returns its canonical form.
+func UnmarshalMacAddress(v interface{}) (string, error) {
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return "", err
+	}
+	a, err := mac.Parse(s)
+	if err != nil {
+		return "", invalidScalar(err, s)
+	}
+	return a.String(), nil
+}
+
+// MarshalIPv4 serves an IPv4 address as stored.
+func MarshalIPv4(s string) graphql.Marshaler {
+	return graphql.MarshalString(s)
+}
+
+// UnmarshalIPv4 accepts a dotted decimal IPv4 address.
+func UnmarshalIPv4(v interface{}) (string, error) {
+	return unmarshalAddr(v, "IPv4", netip.Addr.Is4)
+}
+
+// MarshalIPv6 serves an IPv6 address as stored.
+func MarshalIPv6(s string) graphql.Marshaler {
+	return graphql.MarshalString(s)
+}
+
+// UnmarshalIPv6 accepts an IPv6 address in any RFC 4291 notation and returns its RFC 5952 form.
+func UnmarshalIPv6(v interface{}) (string, error) {
+	return unmarshalAddr(v, "IPv6", func(a netip.Addr) bool { return a.Is6() && !a.Is4In6() })
+}
+
+func unmarshalAddr(v interface{}, name string, is func(netip.Addr) bool) (string, error) {
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return "", err
+	}
+	a, err := netip.ParseAddr(s)
+	if err != nil || a.Zone() != "" || !is(a) {
+		return "", invalidScalar(fmt.Errorf("invalid %s address: %q", name, s), s)
+	}
+	return a.String(), nil
+}
+
+// MarshalDate serves a date in its stored YYYYMMDD form.
+func MarshalDate(s string) graphql.Marshaler {
+	return graphql.MarshalString(s)
+}
+
+// UnmarshalDate accepts a calendar date as YYYYMMDD or YYYY-MM-DD and returns it as YYYYMMDD.
+func UnmarshalDate(v interface{}) (string, error) {
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return "", err
+	}
+	for _, layout := range []string{dateLayout, time.DateOnly} {
+		if d, err := time.Parse(layout, s); err == nil {
+			return d.Format(dateLayout), nil
+		}
+	}
+	return "", invalidScalar(fmt.Errorf("invalid date: %q, want YYYYMMDD", s), s)
+}
+
+// MarshalTime serves a time as RFC 3339.
+func MarshalTime(t time.Time) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(t.Format(time.RFC3339)))
+	})
+}
+
+// UnmarshalTime accepts an RFC 3339 time.
+func UnmarshalTime(v interface{}) (time.Time, error) {
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, invalidScalar(fmt.Errorf("invalid time: %q, want RFC 3339", s), s)
+	}
+	return t, nil
+}
+
+// ParseTime reads a stored timestamp, which is RFC 3339 or a bare "YYYY-MM-DD hh:mm:ss" in UTC.
+func ParseTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, time.DateTime} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// invalidScalar reports a rejected argument as a validation error, listing the offending values in the
+// "invalid" extension.
+func invalidScalar(err error, invalid ...string) error {
+	return &gqlerror.Error{
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code":    errcode.ValidationFailed,
+			"invalid": invalid,
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestUnmarshalScalars(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name      string
+		unmarshal func(interface{}) (string, error)
+		in        interface{}
+		want      string
+		wantErr   bool
+	}{
+		{"mac canonical", UnmarshalMacAddress, "5c:22:da:0e:9f:01", "5c:22:da:0e:9f:01", false},
+		{"mac upper case", UnmarshalMacAddress, "5C:22:DA:0E:9F:01", "5c:22:da:0e:9f:01", false},
+		{"mac bare", UnmarshalMacAddress, "5c22da0e9f01", "5c:22:da:0e:9f:01", false},
+		{"mac dotted", UnmarshalMacAddress, "5c22.da0e.9f01", "5c:22:da:0e:9f:01", false},
+		{"mac short", UnmarshalMacAddress, "5c22da", "", true},
+		{"mac hostile", UnmarshalMacAddress, "' OR 1=1 --", "", true},
+		{"mac not a string", UnmarshalMacAddress, 42, "", true},
+		{"ipv4", UnmarshalIPv4, "10.1.2.3", "10.1.2.3", false},
+		{"ipv4 given ipv6", UnmarshalIPv4, "2001:db8::1", "", true},
+		{"ipv4 out of range", UnmarshalIPv4, "10.1.2.300", "", true},
+		{"ipv6", UnmarshalIPv6, "2001:DB8:0:0::1", "2001:db8::1", false},
+		{"ipv6 given ipv4", UnmarshalIPv6, "10.1.2.3", "", true},
+		{"ipv6 mapped ipv4", UnmarshalIPv6, "::ffff:10.1.2.3", "", true},
+		{"ipv6 zone", UnmarshalIPv6, "fe80::1%eth0", "", true},
+		{"date", UnmarshalDate, "20260901", "20260901", false},
+		{"date iso", UnmarshalDate, "2026-09-01", "20260901", false},
+		{"date impossible", UnmarshalDate, "20260231", "", true},
+		{"date empty", UnmarshalDate, "", "", true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.unmarshal(tt.in)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("got %q, %v, want %q (error %v)", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	t.Parallel()
+	at, err := UnmarshalTime("2026-09-01T12:30:00+02:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := marshaled(MarshalTime(at)); got != `"2026-09-01T12:30:00+02:00"` {
+		t.Errorf("got %s", got)
+	}
+	if _, err := UnmarshalTime("2026-09-01"); err == nil {
+		t.Error("expected a date without a time to be rejected")
+	}
+	for _, s := range []string{"2026-09-01T10:30:00Z", "2026-09-01 10:30:00"} {
+		if got, ok := ParseTime(s); !ok || !got.Equal(at) {
+			t.Errorf("ParseTime(%q) = %v, %v", s, got, ok)
+		}
+	}
+}
+
+func TestMarshalMacAddressIsCanonical(t *testing.T) {
+	t.Parallel()
+	if got := marshaled(MarshalMacAddress("5C:22:DA:0E:9F:01")); got != `"5c:22:da:0e:9f:01"` {
+		t.Errorf("got %s", got)
+	}
+}
+
+func marshaled(m graphql.Marshaler) string {
+	var b bytes.Buffer
+	m.MarshalGQL(&b)
+	return b.String()
+}
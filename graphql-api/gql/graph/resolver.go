@@ -1,6 +1,6 @@
 package graph
 
-import "database/sql"
+import "api-project/pkg/cablemodems"
 
 // This file will not be regenerated automatically.
 //
@@ -9,6 +9,5 @@ import "database/sql"
 //go:generate go run github.com/99designs/gqlgen
 
 type Resolver struct {
-	DBRead  *sql.DB
-	DBWrite *sql.DB
+	Repo cablemodems.Store
 }
@@ -6,14 +6,12 @@ package graph
 
 import (
 	"api-project/graphql-api/gql/graph/cablemodems"
-	"api-project/graphql-api/gql/graph/model"
 	"context"
-	"fmt"
 )
 
-// CreateTodo is the resolver for the createTodo field.
-func (r *mutationResolver) CreateTodo(ctx context.Context, input model.NewTodo) (*model.Todo, error) {
-	panic(fmt.Errorf("not implemented: CreateTodo - createTodo"))
+// CableModems is the resolver for the cableModems field.
+func (r *mutationResolver) CableModems(ctx context.Context) (*cablemodems.CableModemsMutations, error) {
+	return stubModemsMutationResolver, nil
 }
 
 // CableModems is the resolver for the cableModems field.
@@ -2,7 +2,11 @@ package main
 
 import (
 	"api-project/graphql-api/gql/graph"
+	"api-project/pkg/cablemodems"
 	"api-project/pkg/dbservice"
+	"context"
+	_ "expvar"
+	"flag"
 	"log"
 	"net/http"
 	"os"
@@ -18,15 +22,29 @@ import (
 const defaultPort = "8080"
 
 func main() {
+	fixture := flag.String("fixture", "", "serve the modems of this .json or .csv fixture from memory instead of Postgres")
+	flag.Parse()
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = defaultPort
 	}
 
-	dbService := dbservice.DbService
+	var repo cablemodems.Store
+	ready := func() bool { return true }
+	if *fixture != "" {
+		modems, err := cablemodems.LoadFixture(*fixture)
+		if err != nil {
+			log.Fatalf("load fixture: %v", err)
+		}
+		repo = cablemodems.NewMemory(modems)
+	} else {
+		dbService := dbservice.New(context.Background(), dbservice.OptionsFromEnv())
+		repo = cablemodems.NewPostgres(dbService)
+		ready = dbService.Ready
+	}
 	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: &graph.Resolver{
-		DBRead:  dbService.DbReader,
-		DBWrite: dbService.DbWriter,
+		Repo: repo,
 	}}))
 
 	srv.AddTransport(transport.Options{})
@@ -41,7 +59,15 @@ func main() {
 	})
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	// a mutation's own selection, and later requests that send back X-Last-Write, read what it wrote.
+	http.Handle("/query", dbservice.SessionHandler(srv))
+	http.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		if !ready() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ready"))
+	})
 
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
 	log.Fatal(http.ListenAndServe(":"+port, nil))
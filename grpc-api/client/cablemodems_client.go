@@ -32,5 +32,5 @@ func (c *CableModemsClient) ByMac(macs []string) {
 		log.Fatalf("could not get cable modem: %v", err)
 	}
 	test, _ := json.Marshal(resp)
-	log.Printf("Response: ", string(test))
+	log.Printf("Response: %s", string(test))
 }
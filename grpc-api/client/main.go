@@ -1,13 +1,22 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"google.golang.org/grpc"
+
+	"api-project/grpc-api/tlsconfig"
 )
 
 func main() {
-	conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+	creds, reloader, err := tlsconfig.FromEnv().ClientCredentials()
+	if err != nil {
+		log.Fatalf("failed to load TLS certificates: %v", err)
+	}
+	go reloader.Watch(context.Background())
+
+	conn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(creds))
 	if err != nil {
 		log.Fatalf("failed to connect: %v", err)
 	}
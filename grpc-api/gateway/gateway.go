@@ -0,0 +1,83 @@
+// Package gateway serves the CableModemService over HTTP/JSON through the handlers grpc-gateway generates from the
+// google.api.http options of cablemodems.proto, so that every RPC is reachable over REST with the field names and
+// enum names of its proto messages.
+package gateway
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"api-project/grpc-api/gen/cablemodems"
+	"api-project/grpc-api/interceptors"
+	"api-project/grpc-api/methods"
+	cmrepo "api-project/pkg/cablemodems"
+	"api-project/pkg/dbservice"
+)
+
+// maxMsgSize matches the gRPC server's limit, so a ByMac of a million macs passes the gateway both ways.
+const maxMsgSize = 64 << 20
+
+// New returns a handler serving the RPCs of a CableModemService backed by repo, and a func that stops it. The
+// RPCs run on a gRPC server inside the process, behind the same interceptors as the standalone server, and the
+// generated handlers reach it over an in-memory connection: unlike calling the service directly, this supports
+// the streaming RPCs. The X-Request-Id header is passed through both ways, and X-Last-Write to the RPCs.
+func New(ctx context.Context, repo cmrepo.Store) (http.Handler, func(), error) {
+	srv := grpc.NewServer(append(interceptors.ServerOptions(), grpc.MaxRecvMsgSize(maxMsgSize))...)
+	cablemodems.RegisterCableModemServiceServer(srv, &methods.CableModemMethod{Repo: repo})
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize), grpc.MaxCallSendMsgSize(maxMsgSize)))
+	if err != nil {
+		srv.Stop()
+		return nil, nil, err
+	}
+	stop := func() {
+		conn.Close()
+		srv.Stop()
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+	)
+	if err := cablemodems.RegisterCableModemServiceHandler(ctx, mux, conn); err != nil {
+		stop()
+		return nil, nil, err
+	}
+	return mux, stop, nil
+}
+
+// requestIDHeader is the HTTP spelling of interceptors.RequestIDKey.
+var requestIDHeader = textproto.CanonicalMIMEHeaderKey(interceptors.RequestIDKey)
+
+// incomingHeader forwards X-Request-Id and X-Last-Write as their metadata, besides the headers grpc-gateway
+// forwards by default.
+func incomingHeader(key string) (string, bool) {
+	switch textproto.CanonicalMIMEHeaderKey(key) {
+	case requestIDHeader:
+		return interceptors.RequestIDKey, true
+	case dbservice.LastWriteHeader:
+		return interceptors.LastWriteKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeader returns the request ID as X-Request-Id, and other response metadata with the Grpc-Metadata-
+// prefix as grpc-gateway does by default.
+func outgoingHeader(key string) (string, bool) {
+	if key == interceptors.RequestIDKey {
+		return requestIDHeader, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
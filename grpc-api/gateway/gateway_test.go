@@ -0,0 +1,151 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	cmrepo "api-project/pkg/cablemodems"
+)
+
+func newTestHandler(t *testing.T) http.Handler {
+	t.Helper()
+	modems, err := cmrepo.LoadFixture("../../pkg/cablemodems/testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, stop, err := New(context.Background(), cmrepo.NewMemory(modems))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(stop)
+	return h
+}
+
+// macs returns the macs of a response's modems, or of the modems of a stream's {"result": ...} lines.
+func macs(t *testing.T, body string) []string {
+	t.Helper()
+	var got []string
+	sc := bufio.NewScanner(strings.NewReader(body))
+	for sc.Scan() {
+		var line struct {
+			Modems []struct{ Mac string }
+			Result struct {
+				Mac   string
+				Modem struct{ Mac string }
+			}
+		}
+		if err := json.Unmarshal(sc.Bytes(), &line); err != nil {
+			t.Fatalf("%s: %v", sc.Text(), err)
+		}
+		for _, m := range line.Modems {
+			got = append(got, m.Mac)
+		}
+		if mac := line.Result.Mac + line.Result.Modem.Mac; mac != "" {
+			got = append(got, mac)
+		}
+	}
+	return got
+}
+
+func TestGateway(t *testing.T) {
+	t.Parallel()
+	h := newTestHandler(t)
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		status int
+		macs   []string
+	}{
+		{"ByMac", http.MethodGet, "/v1/cablemodems/by-mac?macAddress=5c:22:da:0e:9f:01&macAddress=5C:22:DA:0E:9F:03", "", http.StatusOK,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:03"}},
+		{"ByMac POST", http.MethodPost, "/v1/cablemodems/by-mac", `{"macAddress": ["5c:22:da:0e:9f:02"]}`, http.StatusOK,
+			[]string{"5c:22:da:0e:9f:02"}},
+		{"ByMac invalid", http.MethodGet, "/v1/cablemodems/by-mac?macAddress=zz", "", http.StatusBadRequest, nil},
+		{"ByCmts", http.MethodGet, "/v1/cmts/acr01.den.example.net/cablemodems?state=OFFLINE&docsis=DOCSIS3", "", http.StatusOK,
+			[]string{"5c:22:da:0e:9f:03"}},
+		{"ByCmts bad enum", http.MethodGet, "/v1/cmts/acr01.den.example.net/cablemodems?state=Sleeping", "", http.StatusBadRequest, nil},
+		{"ByPoller", http.MethodGet, "/v1/pollers/SNMP/cablemodems?cmts=den01", "", http.StatusBadRequest, nil},
+		{"Paged", http.MethodGet, "/v1/cablemodems?first=2", "", http.StatusOK, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"Paged filter", http.MethodGet, "/v1/cablemodems?filter.fiberNode=FN-102", "", http.StatusOK,
+			[]string{"5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"StreamByCmts", http.MethodGet, "/v1/cmts/acr01.den.example.net/cablemodems:stream?state=ONLINE", "", http.StatusOK,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:04"}},
+		{"StreamPaged", http.MethodGet, "/v1/cablemodems:stream?first=1&filter.fiberNode=FN-102", "", http.StatusOK,
+			[]string{"5c:22:da:0e:9f:03"}},
+		{"unknown route", http.MethodGet, "/v1/cablemodems/by-ip", "", http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+			if w.Code != tt.status {
+				t.Fatalf("status %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			if got := macs(t, w.Body.String()); !reflect.DeepEqual(got, tt.macs) {
+				t.Errorf("got %v, want %v", got, tt.macs)
+			}
+		})
+	}
+}
+
+func TestGatewayEncoding(t *testing.T) {
+	t.Parallel()
+	h := newTestHandler(t)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/v1/cablemodems/by-mac?macAddress=5c:22:da:0e:9f:01&macAddress=ff:ff:ff:ff:ff:ff", nil)
+	r.Header.Set("X-Request-Id", "poller-42")
+	h.ServeHTTP(w, r)
+	if got := w.Header().Get("X-Request-Id"); got != "poller-42" {
+		t.Errorf("X-Request-Id: got %q", got)
+	}
+
+	var resp struct {
+		Modems []map[string]interface{}
+		Error  struct {
+			Code    int
+			Message string
+		}
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Modems) != 1 {
+		t.Fatalf("got %s", w.Body)
+	}
+	// field names follow the REST API, enums are spelled by their proto names.
+	m := resp.Modems[0]
+	for field, want := range map[string]interface{}{
+		"cpeMac":        "5c:22:da:0e:9f:81",
+		"macDomain":     "Cable1/0/0",
+		"isCPE":         false,
+		"state":         "ONLINE",
+		"docsisVersion": "DOCSIS31",
+		"regState":      float64(6),
+		"updatedAtTs":   "1760000000",
+	} {
+		if m[field] != want {
+			t.Errorf("%s: got %#v, want %#v", field, m[field], want)
+		}
+	}
+	if _, ok := m["ipv6"]; ok {
+		t.Errorf("NULL column encoded: %v", m)
+	}
+	if resp.Error.Code != 5 || !strings.Contains(resp.Error.Message, "ff:ff:ff:ff:ff:ff") {
+		t.Errorf("partial failure: got %+v", resp.Error)
+	}
+}
@@ -8,6 +8,7 @@ package cablemodems
 
 import (
 	common "api-project/grpc-api/gen/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -524,6 +525,7 @@ type PagedResponse struct {
 	Modems        []*CableModem          `protobuf:"bytes,1,rep,name=modems,proto3" json:"modems,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	Error         *common.Error          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -579,17 +581,81 @@ func (x *PagedResponse) GetError() *common.Error {
 	return nil
 }
 
+func (x *PagedResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+type PagedItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Modem *CableModem            `protobuf:"bytes,1,opt,name=modem,proto3" json:"modem,omitempty"`
+	// resumes a StreamPaged or Paged right after modem.
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagedItem) Reset() {
+	*x = PagedItem{}
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PagedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagedItem) ProtoMessage() {}
+
+func (x *PagedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagedItem.ProtoReflect.Descriptor instead.
+func (*PagedItem) Descriptor() ([]byte, []int) {
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PagedItem) GetModem() *CableModem {
+	if x != nil {
+		return x.Modem
+	}
+	return nil
+}
+
+func (x *PagedItem) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type HistoricalRegStateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mac           []string               `protobuf:"bytes,1,rep,name=mac,proto3" json:"mac,omitempty"`
-	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mac   []string               `protobuf:"bytes,1,rep,name=mac,proto3" json:"mac,omitempty"`
+	// Minutely or Hourly
+	Period string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	// range in unix seconds, [from, to). 0 means unset: to defaults to now, from to a day (Minutely) or week (Hourly) before to.
+	From          int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HistoricalRegStateRequest) Reset() {
 	*x = HistoricalRegStateRequest{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[8]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +667,7 @@ func (x *HistoricalRegStateRequest) String() string {
 func (*HistoricalRegStateRequest) ProtoMessage() {}
 
 func (x *HistoricalRegStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[8]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +680,7 @@ func (x *HistoricalRegStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalRegStateRequest.ProtoReflect.Descriptor instead.
 func (*HistoricalRegStateRequest) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{8}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *HistoricalRegStateRequest) GetMac() []string {
@@ -631,6 +697,20 @@ func (x *HistoricalRegStateRequest) GetPeriod() string {
 	return ""
 }
 
+func (x *HistoricalRegStateRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *HistoricalRegStateRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
 type HistoricalRegStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Devices       []*TsRegStateDevice    `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
@@ -641,7 +721,7 @@ type HistoricalRegStateResponse struct {
 
 func (x *HistoricalRegStateResponse) Reset() {
 	*x = HistoricalRegStateResponse{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[9]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -653,7 +733,7 @@ func (x *HistoricalRegStateResponse) String() string {
 func (*HistoricalRegStateResponse) ProtoMessage() {}
 
 func (x *HistoricalRegStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[9]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +746,7 @@ func (x *HistoricalRegStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalRegStateResponse.ProtoReflect.Descriptor instead.
 func (*HistoricalRegStateResponse) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{9}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *HistoricalRegStateResponse) GetDevices() []*TsRegStateDevice {
@@ -684,15 +764,18 @@ func (x *HistoricalRegStateResponse) GetError() *common.Error {
 }
 
 type HistoricalCmRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mac           []string               `protobuf:"bytes,1,rep,name=mac,proto3" json:"mac,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mac   []string               `protobuf:"bytes,1,rep,name=mac,proto3" json:"mac,omitempty"`
+	// range in unix seconds, [from, to). 0 means unset: to defaults to now, from to a day before to.
+	From          int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HistoricalCmRequest) Reset() {
 	*x = HistoricalCmRequest{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[10]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +787,7 @@ func (x *HistoricalCmRequest) String() string {
 func (*HistoricalCmRequest) ProtoMessage() {}
 
 func (x *HistoricalCmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[10]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +800,7 @@ func (x *HistoricalCmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalCmRequest.ProtoReflect.Descriptor instead.
 func (*HistoricalCmRequest) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{10}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *HistoricalCmRequest) GetMac() []string {
@@ -727,6 +810,20 @@ func (x *HistoricalCmRequest) GetMac() []string {
 	return nil
 }
 
+func (x *HistoricalCmRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *HistoricalCmRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
 type HistoricalCmResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Devices       []*TsCmDevice          `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
@@ -737,7 +834,7 @@ type HistoricalCmResponse struct {
 
 func (x *HistoricalCmResponse) Reset() {
 	*x = HistoricalCmResponse{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[11]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +846,7 @@ func (x *HistoricalCmResponse) String() string {
 func (*HistoricalCmResponse) ProtoMessage() {}
 
 func (x *HistoricalCmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[11]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +859,7 @@ func (x *HistoricalCmResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalCmResponse.ProtoReflect.Descriptor instead.
 func (*HistoricalCmResponse) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{11}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *HistoricalCmResponse) GetDevices() []*TsCmDevice {
@@ -785,13 +882,17 @@ type CableModemsFilter struct {
 	MacDomain     string                 `protobuf:"bytes,2,opt,name=mac_domain,json=macDomain,proto3" json:"mac_domain,omitempty"`
 	PpodName      string                 `protobuf:"bytes,3,opt,name=ppod_name,json=ppodName,proto3" json:"ppod_name,omitempty"`
 	MacAddress    []string               `protobuf:"bytes,4,rep,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	DocsisVersion DocsisVersion          `protobuf:"varint,5,opt,name=docsis_version,json=docsisVersion,proto3,enum=cablemodems.DocsisVersion" json:"docsis_version,omitempty"`
+	DsInterface   string                 `protobuf:"bytes,6,opt,name=ds_interface,json=dsInterface,proto3" json:"ds_interface,omitempty"`
+	FiberNode     string                 `protobuf:"bytes,7,opt,name=fiber_node,json=fiberNode,proto3" json:"fiber_node,omitempty"`
+	Transponder   *bool                  `protobuf:"varint,8,opt,name=transponder,proto3,oneof" json:"transponder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CableModemsFilter) Reset() {
 	*x = CableModemsFilter{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[12]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -803,7 +904,7 @@ func (x *CableModemsFilter) String() string {
 func (*CableModemsFilter) ProtoMessage() {}
 
 func (x *CableModemsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[12]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +917,7 @@ func (x *CableModemsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CableModemsFilter.ProtoReflect.Descriptor instead.
 func (*CableModemsFilter) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{12}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CableModemsFilter) GetFqdn() string {
@@ -847,6 +948,34 @@ func (x *CableModemsFilter) GetMacAddress() []string {
 	return nil
 }
 
+func (x *CableModemsFilter) GetDocsisVersion() DocsisVersion {
+	if x != nil {
+		return x.DocsisVersion
+	}
+	return DocsisVersion_DOCSIS_UNKNOWN
+}
+
+func (x *CableModemsFilter) GetDsInterface() string {
+	if x != nil {
+		return x.DsInterface
+	}
+	return ""
+}
+
+func (x *CableModemsFilter) GetFiberNode() string {
+	if x != nil {
+		return x.FiberNode
+	}
+	return ""
+}
+
+func (x *CableModemsFilter) GetTransponder() bool {
+	if x != nil && x.Transponder != nil {
+		return *x.Transponder
+	}
+	return false
+}
+
 type CableModem struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Mac                string                 `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
@@ -876,16 +1005,17 @@ type CableModem struct {
 	OltName            *string                `protobuf:"bytes,25,opt,name=olt_name,json=oltName,proto3,oneof" json:"olt_name,omitempty"`
 	PonName            *string                `protobuf:"bytes,26,opt,name=pon_name,json=ponName,proto3,oneof" json:"pon_name,omitempty"`
 	UpdatedAtTs        *int64                 `protobuf:"varint,27,opt,name=updated_at_ts,json=updatedAtTs,proto3,oneof" json:"updated_at_ts,omitempty"`
-	IsCpe              *bool                  `protobuf:"varint,28,opt,name=is_cpe,json=isCpe,proto3,oneof" json:"is_cpe,omitempty"`
-	CmtsType           *string                `protobuf:"bytes,29,opt,name=cmts_type,json=cmtsType,proto3,oneof" json:"cmts_type,omitempty"`
-	DeviceType         *int32                 `protobuf:"varint,30,opt,name=device_type,json=deviceType,proto3,oneof" json:"device_type,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// isCPE, as the REST and GraphQL APIs spell it.
+	IsCpe         *bool   `protobuf:"varint,28,opt,name=is_cpe,json=isCPE,proto3,oneof" json:"is_cpe,omitempty"`
+	CmtsType      *string `protobuf:"bytes,29,opt,name=cmts_type,json=cmtsType,proto3,oneof" json:"cmts_type,omitempty"`
+	DeviceType    *int32  `protobuf:"varint,30,opt,name=device_type,json=deviceType,proto3,oneof" json:"device_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CableModem) Reset() {
 	*x = CableModem{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[13]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +1027,7 @@ func (x *CableModem) String() string {
 func (*CableModem) ProtoMessage() {}
 
 func (x *CableModem) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[13]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1040,7 @@ func (x *CableModem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CableModem.ProtoReflect.Descriptor instead.
 func (*CableModem) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{13}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CableModem) GetMac() string {
@@ -1134,7 +1264,7 @@ type TsRegStateDevice struct {
 
 func (x *TsRegStateDevice) Reset() {
 	*x = TsRegStateDevice{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[14]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1146,7 +1276,7 @@ func (x *TsRegStateDevice) String() string {
 func (*TsRegStateDevice) ProtoMessage() {}
 
 func (x *TsRegStateDevice) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[14]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +1289,7 @@ func (x *TsRegStateDevice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TsRegStateDevice.ProtoReflect.Descriptor instead.
 func (*TsRegStateDevice) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{14}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TsRegStateDevice) GetMac() string {
@@ -1184,17 +1314,23 @@ func (x *TsRegStateDevice) GetTimestamp() int64 {
 }
 
 type TsCmDevice struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mac           string                 `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	Mac                 string                   `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
+	Status              string                   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp           int64                    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	LostSync            *int32                   `protobuf:"varint,4,opt,name=lost_sync,json=lostSync,proto3,oneof" json:"lost_sync,omitempty"`
+	Resets              *int32                   `protobuf:"varint,5,opt,name=resets,proto3,oneof" json:"resets,omitempty"`
+	CableDownstream     []*TsCableDownstream     `protobuf:"bytes,6,rep,name=cable_downstream,json=cableDownstream,proto3" json:"cable_downstream,omitempty"`
+	CableUpstream       []*TsCableUpstream       `protobuf:"bytes,7,rep,name=cable_upstream,json=cableUpstream,proto3" json:"cable_upstream,omitempty"`
+	CableUpstreamStatus []*TsCableUpstreamStatus `protobuf:"bytes,8,rep,name=cable_upstream_status,json=cableUpstreamStatus,proto3" json:"cable_upstream_status,omitempty"`
+	OfdmDownstream      []*TsOfdmDownstream      `protobuf:"bytes,9,rep,name=ofdm_downstream,json=ofdmDownstream,proto3" json:"ofdm_downstream,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *TsCmDevice) Reset() {
 	*x = TsCmDevice{}
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[15]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1206,7 +1342,7 @@ func (x *TsCmDevice) String() string {
 func (*TsCmDevice) ProtoMessage() {}
 
 func (x *TsCmDevice) ProtoReflect() protoreflect.Message {
-	mi := &file_cablemodems_cablemodems_proto_msgTypes[15]
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1355,7 @@ func (x *TsCmDevice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TsCmDevice.ProtoReflect.Descriptor instead.
 func (*TsCmDevice) Descriptor() ([]byte, []int) {
-	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{15}
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TsCmDevice) GetMac() string {
@@ -1243,11 +1379,469 @@ func (x *TsCmDevice) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *TsCmDevice) GetLostSync() int32 {
+	if x != nil && x.LostSync != nil {
+		return *x.LostSync
+	}
+	return 0
+}
+
+func (x *TsCmDevice) GetResets() int32 {
+	if x != nil && x.Resets != nil {
+		return *x.Resets
+	}
+	return 0
+}
+
+func (x *TsCmDevice) GetCableDownstream() []*TsCableDownstream {
+	if x != nil {
+		return x.CableDownstream
+	}
+	return nil
+}
+
+func (x *TsCmDevice) GetCableUpstream() []*TsCableUpstream {
+	if x != nil {
+		return x.CableUpstream
+	}
+	return nil
+}
+
+func (x *TsCmDevice) GetCableUpstreamStatus() []*TsCableUpstreamStatus {
+	if x != nil {
+		return x.CableUpstreamStatus
+	}
+	return nil
+}
+
+func (x *TsCmDevice) GetOfdmDownstream() []*TsOfdmDownstream {
+	if x != nil {
+		return x.OfdmDownstream
+	}
+	return nil
+}
+
+type TsCableDownstream struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	IfIndex            *int32                 `protobuf:"varint,1,opt,name=if_index,json=ifIndex,proto3,oneof" json:"if_index,omitempty"`
+	ChannelPower       *string                `protobuf:"bytes,2,opt,name=channel_power,json=channelPower,proto3,oneof" json:"channel_power,omitempty"`
+	Unerroreds         *string                `protobuf:"bytes,3,opt,name=unerroreds,proto3,oneof" json:"unerroreds,omitempty"`
+	Correcteds         *string                `protobuf:"bytes,4,opt,name=correcteds,proto3,oneof" json:"correcteds,omitempty"`
+	Uncorrectables     *string                `protobuf:"bytes,5,opt,name=uncorrectables,proto3,oneof" json:"uncorrectables,omitempty"`
+	SignalNoiseDecibel *string                `protobuf:"bytes,6,opt,name=signal_noise_decibel,json=signalNoiseDecibel,proto3,oneof" json:"signal_noise_decibel,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TsCableDownstream) Reset() {
+	*x = TsCableDownstream{}
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TsCableDownstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TsCableDownstream) ProtoMessage() {}
+
+func (x *TsCableDownstream) ProtoReflect() protoreflect.Message {
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TsCableDownstream.ProtoReflect.Descriptor instead.
+func (*TsCableDownstream) Descriptor() ([]byte, []int) {
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TsCableDownstream) GetIfIndex() int32 {
+	if x != nil && x.IfIndex != nil {
+		return *x.IfIndex
+	}
+	return 0
+}
+
+func (x *TsCableDownstream) GetChannelPower() string {
+	if x != nil && x.ChannelPower != nil {
+		return *x.ChannelPower
+	}
+	return ""
+}
+
+func (x *TsCableDownstream) GetUnerroreds() string {
+	if x != nil && x.Unerroreds != nil {
+		return *x.Unerroreds
+	}
+	return ""
+}
+
+func (x *TsCableDownstream) GetCorrecteds() string {
+	if x != nil && x.Correcteds != nil {
+		return *x.Correcteds
+	}
+	return ""
+}
+
+func (x *TsCableDownstream) GetUncorrectables() string {
+	if x != nil && x.Uncorrectables != nil {
+		return *x.Uncorrectables
+	}
+	return ""
+}
+
+func (x *TsCableDownstream) GetSignalNoiseDecibel() string {
+	if x != nil && x.SignalNoiseDecibel != nil {
+		return *x.SignalNoiseDecibel
+	}
+	return ""
+}
+
+type TsCableUpstream struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IfIndex          *int32                 `protobuf:"varint,1,opt,name=if_index,json=ifIndex,proto3,oneof" json:"if_index,omitempty"`
+	StatusTxPower    *string                `protobuf:"bytes,2,opt,name=status_tx_power,json=statusTxPower,proto3,oneof" json:"status_tx_power,omitempty"`
+	StatusT3Timeouts *int32                 `protobuf:"varint,3,opt,name=status_t3_timeouts,json=statusT3Timeouts,proto3,oneof" json:"status_t3_timeouts,omitempty"`
+	StatusT4Timeouts *int32                 `protobuf:"varint,4,opt,name=status_t4_timeouts,json=statusT4Timeouts,proto3,oneof" json:"status_t4_timeouts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TsCableUpstream) Reset() {
+	*x = TsCableUpstream{}
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TsCableUpstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TsCableUpstream) ProtoMessage() {}
+
+func (x *TsCableUpstream) ProtoReflect() protoreflect.Message {
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TsCableUpstream.ProtoReflect.Descriptor instead.
+func (*TsCableUpstream) Descriptor() ([]byte, []int) {
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TsCableUpstream) GetIfIndex() int32 {
+	if x != nil && x.IfIndex != nil {
+		return *x.IfIndex
+	}
+	return 0
+}
+
+func (x *TsCableUpstream) GetStatusTxPower() string {
+	if x != nil && x.StatusTxPower != nil {
+		return *x.StatusTxPower
+	}
+	return ""
+}
+
+func (x *TsCableUpstream) GetStatusT3Timeouts() int32 {
+	if x != nil && x.StatusT3Timeouts != nil {
+		return *x.StatusT3Timeouts
+	}
+	return 0
+}
+
+func (x *TsCableUpstream) GetStatusT4Timeouts() int32 {
+	if x != nil && x.StatusT4Timeouts != nil {
+		return *x.StatusT4Timeouts
+	}
+	return 0
+}
+
+type TsCableUpstreamStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IfDescr       *string                `protobuf:"bytes,1,opt,name=if_descr,json=ifDescr,proto3,oneof" json:"if_descr,omitempty"`
+	Snr           *string                `protobuf:"bytes,2,opt,name=snr,proto3,oneof" json:"snr,omitempty"`
+	RxPower       *string                `protobuf:"bytes,3,opt,name=rx_power,json=rxPower,proto3,oneof" json:"rx_power,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TsCableUpstreamStatus) Reset() {
+	*x = TsCableUpstreamStatus{}
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TsCableUpstreamStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TsCableUpstreamStatus) ProtoMessage() {}
+
+func (x *TsCableUpstreamStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TsCableUpstreamStatus.ProtoReflect.Descriptor instead.
+func (*TsCableUpstreamStatus) Descriptor() ([]byte, []int) {
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TsCableUpstreamStatus) GetIfDescr() string {
+	if x != nil && x.IfDescr != nil {
+		return *x.IfDescr
+	}
+	return ""
+}
+
+func (x *TsCableUpstreamStatus) GetSnr() string {
+	if x != nil && x.Snr != nil {
+		return *x.Snr
+	}
+	return ""
+}
+
+func (x *TsCableUpstreamStatus) GetRxPower() string {
+	if x != nil && x.RxPower != nil {
+		return *x.RxPower
+	}
+	return ""
+}
+
+type TsOfdmDownstream struct {
+	state               protoimpl.MessageState         `protogen:"open.v1"`
+	IfIndex             *int32                         `protobuf:"varint,1,opt,name=if_index,json=ifIndex,proto3,oneof" json:"if_index,omitempty"`
+	RxMerMean           *string                        `protobuf:"bytes,2,opt,name=rx_mer_mean,json=rxMerMean,proto3,oneof" json:"rx_mer_mean,omitempty"`
+	RxMer_2NdPercentile *string                        `protobuf:"bytes,3,opt,name=rx_mer_2nd_percentile,json=rxMer2ndPercentile,proto3,oneof" json:"rx_mer_2nd_percentile,omitempty"`
+	ProfileStats        []*TsCmOfdmChannelProfileStats `protobuf:"bytes,4,rep,name=profile_stats,json=profileStats,proto3" json:"profile_stats,omitempty"`
+	OfdmDsChannelPower  []*TsCmOfdmChannelPower        `protobuf:"bytes,5,rep,name=ofdm_ds_channel_power,json=ofdmDsChannelPower,proto3" json:"ofdm_ds_channel_power,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TsOfdmDownstream) Reset() {
+	*x = TsOfdmDownstream{}
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TsOfdmDownstream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TsOfdmDownstream) ProtoMessage() {}
+
+func (x *TsOfdmDownstream) ProtoReflect() protoreflect.Message {
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TsOfdmDownstream.ProtoReflect.Descriptor instead.
+func (*TsOfdmDownstream) Descriptor() ([]byte, []int) {
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TsOfdmDownstream) GetIfIndex() int32 {
+	if x != nil && x.IfIndex != nil {
+		return *x.IfIndex
+	}
+	return 0
+}
+
+func (x *TsOfdmDownstream) GetRxMerMean() string {
+	if x != nil && x.RxMerMean != nil {
+		return *x.RxMerMean
+	}
+	return ""
+}
+
+func (x *TsOfdmDownstream) GetRxMer_2NdPercentile() string {
+	if x != nil && x.RxMer_2NdPercentile != nil {
+		return *x.RxMer_2NdPercentile
+	}
+	return ""
+}
+
+func (x *TsOfdmDownstream) GetProfileStats() []*TsCmOfdmChannelProfileStats {
+	if x != nil {
+		return x.ProfileStats
+	}
+	return nil
+}
+
+func (x *TsOfdmDownstream) GetOfdmDsChannelPower() []*TsCmOfdmChannelPower {
+	if x != nil {
+		return x.OfdmDsChannelPower
+	}
+	return nil
+}
+
+type TsCmOfdmChannelProfileStats struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CmtsProfileId         *int32                 `protobuf:"varint,1,opt,name=cmts_profile_id,json=cmtsProfileId,proto3,oneof" json:"cmts_profile_id,omitempty"`
+	CorrectedCodewords    *string                `protobuf:"bytes,2,opt,name=corrected_codewords,json=correctedCodewords,proto3,oneof" json:"corrected_codewords,omitempty"`
+	UncorrectableCodeword *string                `protobuf:"bytes,3,opt,name=uncorrectable_codeword,json=uncorrectableCodeword,proto3,oneof" json:"uncorrectable_codeword,omitempty"`
+	TotalCodewords        *string                `protobuf:"bytes,4,opt,name=total_codewords,json=totalCodewords,proto3,oneof" json:"total_codewords,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TsCmOfdmChannelProfileStats) Reset() {
+	*x = TsCmOfdmChannelProfileStats{}
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TsCmOfdmChannelProfileStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TsCmOfdmChannelProfileStats) ProtoMessage() {}
+
+func (x *TsCmOfdmChannelProfileStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TsCmOfdmChannelProfileStats.ProtoReflect.Descriptor instead.
+func (*TsCmOfdmChannelProfileStats) Descriptor() ([]byte, []int) {
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TsCmOfdmChannelProfileStats) GetCmtsProfileId() int32 {
+	if x != nil && x.CmtsProfileId != nil {
+		return *x.CmtsProfileId
+	}
+	return 0
+}
+
+func (x *TsCmOfdmChannelProfileStats) GetCorrectedCodewords() string {
+	if x != nil && x.CorrectedCodewords != nil {
+		return *x.CorrectedCodewords
+	}
+	return ""
+}
+
+func (x *TsCmOfdmChannelProfileStats) GetUncorrectableCodeword() string {
+	if x != nil && x.UncorrectableCodeword != nil {
+		return *x.UncorrectableCodeword
+	}
+	return ""
+}
+
+func (x *TsCmOfdmChannelProfileStats) GetTotalCodewords() string {
+	if x != nil && x.TotalCodewords != nil {
+		return *x.TotalCodewords
+	}
+	return ""
+}
+
+type TsCmOfdmChannelPower struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ChannelBandIndex *int32                 `protobuf:"varint,1,opt,name=channel_band_index,json=channelBandIndex,proto3,oneof" json:"channel_band_index,omitempty"`
+	CenterFrequency  *int32                 `protobuf:"varint,2,opt,name=center_frequency,json=centerFrequency,proto3,oneof" json:"center_frequency,omitempty"`
+	RxPower          *string                `protobuf:"bytes,3,opt,name=rx_power,json=rxPower,proto3,oneof" json:"rx_power,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TsCmOfdmChannelPower) Reset() {
+	*x = TsCmOfdmChannelPower{}
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TsCmOfdmChannelPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TsCmOfdmChannelPower) ProtoMessage() {}
+
+func (x *TsCmOfdmChannelPower) ProtoReflect() protoreflect.Message {
+	mi := &file_cablemodems_cablemodems_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TsCmOfdmChannelPower.ProtoReflect.Descriptor instead.
+func (*TsCmOfdmChannelPower) Descriptor() ([]byte, []int) {
+	return file_cablemodems_cablemodems_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TsCmOfdmChannelPower) GetChannelBandIndex() int32 {
+	if x != nil && x.ChannelBandIndex != nil {
+		return *x.ChannelBandIndex
+	}
+	return 0
+}
+
+func (x *TsCmOfdmChannelPower) GetCenterFrequency() int32 {
+	if x != nil && x.CenterFrequency != nil {
+		return *x.CenterFrequency
+	}
+	return 0
+}
+
+func (x *TsCmOfdmChannelPower) GetRxPower() string {
+	if x != nil && x.RxPower != nil {
+		return *x.RxPower
+	}
+	return ""
+}
+
 var File_cablemodems_cablemodems_proto protoreflect.FileDescriptor
 
 const file_cablemodems_cablemodems_proto_rawDesc = "" +
 	"\n" +
-	"\x1dcablemodems/cablemodems.proto\x12\vcablemodems\x1a\x13common/common.proto\"/\n" +
+	"\x1dcablemodems/cablemodems.proto\x12\vcablemodems\x1a\x13common/common.proto\x1a\x1cgoogle/api/annotations.proto\"/\n" +
 	"\fByMacRequest\x12\x1f\n" +
 	"\vmac_address\x18\x01 \x03(\tR\n" +
 	"macAddress\"e\n" +
@@ -1273,30 +1867,44 @@ const file_cablemodems_cablemodems_proto_rawDesc = "" +
 	"\fPagedRequest\x126\n" +
 	"\x06filter\x18\x01 \x01(\v2\x1e.cablemodems.CableModemsFilterR\x06filter\x12\x14\n" +
 	"\x05first\x18\x02 \x01(\x05R\x05first\x12\x14\n" +
-	"\x05after\x18\x03 \x01(\tR\x05after\"\x86\x01\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xaa\x01\n" +
 	"\rPagedResponse\x12/\n" +
 	"\x06modems\x18\x01 \x03(\v2\x17.cablemodems.CableModemR\x06modems\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
 	"nextCursor\x12#\n" +
-	"\x05error\x18\x03 \x01(\v2\r.common.ErrorR\x05error\"E\n" +
+	"\x05error\x18\x03 \x01(\v2\r.common.ErrorR\x05error\x12\"\n" +
+	"\rhas_next_page\x18\x04 \x01(\bR\vhasNextPage\"R\n" +
+	"\tPagedItem\x12-\n" +
+	"\x05modem\x18\x01 \x01(\v2\x17.cablemodems.CableModemR\x05modem\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\"i\n" +
 	"\x19HistoricalRegStateRequest\x12\x10\n" +
 	"\x03mac\x18\x01 \x03(\tR\x03mac\x12\x16\n" +
-	"\x06period\x18\x02 \x01(\tR\x06period\"z\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\x03R\x02to\"z\n" +
 	"\x1aHistoricalRegStateResponse\x127\n" +
 	"\adevices\x18\x01 \x03(\v2\x1d.cablemodems.TsRegStateDeviceR\adevices\x12#\n" +
-	"\x05error\x18\x02 \x01(\v2\r.common.ErrorR\x05error\"'\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorR\x05error\"K\n" +
 	"\x13HistoricalCmRequest\x12\x10\n" +
-	"\x03mac\x18\x01 \x03(\tR\x03mac\"n\n" +
+	"\x03mac\x18\x01 \x03(\tR\x03mac\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\"n\n" +
 	"\x14HistoricalCmResponse\x121\n" +
 	"\adevices\x18\x01 \x03(\v2\x17.cablemodems.TsCmDeviceR\adevices\x12#\n" +
-	"\x05error\x18\x02 \x01(\v2\r.common.ErrorR\x05error\"\x84\x01\n" +
+	"\x05error\x18\x02 \x01(\v2\r.common.ErrorR\x05error\"\xc0\x02\n" +
 	"\x11CableModemsFilter\x12\x12\n" +
 	"\x04fqdn\x18\x01 \x01(\tR\x04fqdn\x12\x1d\n" +
 	"\n" +
 	"mac_domain\x18\x02 \x01(\tR\tmacDomain\x12\x1b\n" +
 	"\tppod_name\x18\x03 \x01(\tR\bppodName\x12\x1f\n" +
 	"\vmac_address\x18\x04 \x03(\tR\n" +
-	"macAddress\"\xae\v\n" +
+	"macAddress\x12A\n" +
+	"\x0edocsis_version\x18\x05 \x01(\x0e2\x1a.cablemodems.DocsisVersionR\rdocsisVersion\x12!\n" +
+	"\fds_interface\x18\x06 \x01(\tR\vdsInterface\x12\x1d\n" +
+	"\n" +
+	"fiber_node\x18\a \x01(\tR\tfiberNode\x12%\n" +
+	"\vtransponder\x18\b \x01(\bH\x00R\vtransponder\x88\x01\x01B\x0e\n" +
+	"\f_transponder\"\xae\v\n" +
 	"\n" +
 	"CableModem\x12\x10\n" +
 	"\x03mac\x18\x01 \x01(\tR\x03mac\x12\x1c\n" +
@@ -1332,7 +1940,7 @@ const file_cablemodems_cablemodems_proto_rawDesc = "" +
 	"\bolt_name\x18\x19 \x01(\tH\x17R\aoltName\x88\x01\x01\x12\x1e\n" +
 	"\bpon_name\x18\x1a \x01(\tH\x18R\aponName\x88\x01\x01\x12'\n" +
 	"\rupdated_at_ts\x18\x1b \x01(\x03H\x19R\vupdatedAtTs\x88\x01\x01\x12\x1a\n" +
-	"\x06is_cpe\x18\x1c \x01(\bH\x1aR\x05isCpe\x88\x01\x01\x12 \n" +
+	"\x06is_cpe\x18\x1c \x01(\bH\x1aR\x05isCPE\x88\x01\x01\x12 \n" +
 	"\tcmts_type\x18\x1d \x01(\tH\x1bR\bcmtsType\x88\x01\x01\x12$\n" +
 	"\vdevice_type\x18\x1e \x01(\x05H\x1cR\n" +
 	"deviceType\x88\x01\x01B\n" +
@@ -1372,12 +1980,79 @@ const file_cablemodems_cablemodems_proto_rawDesc = "" +
 	"\x10TsRegStateDevice\x12\x10\n" +
 	"\x03mac\x18\x01 \x01(\tR\x03mac\x12\x1b\n" +
 	"\treg_state\x18\x02 \x01(\tR\bregState\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"T\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\xdc\x03\n" +
 	"\n" +
 	"TsCmDevice\x12\x10\n" +
 	"\x03mac\x18\x01 \x01(\tR\x03mac\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp*-\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12 \n" +
+	"\tlost_sync\x18\x04 \x01(\x05H\x00R\blostSync\x88\x01\x01\x12\x1b\n" +
+	"\x06resets\x18\x05 \x01(\x05H\x01R\x06resets\x88\x01\x01\x12I\n" +
+	"\x10cable_downstream\x18\x06 \x03(\v2\x1e.cablemodems.TsCableDownstreamR\x0fcableDownstream\x12C\n" +
+	"\x0ecable_upstream\x18\a \x03(\v2\x1c.cablemodems.TsCableUpstreamR\rcableUpstream\x12V\n" +
+	"\x15cable_upstream_status\x18\b \x03(\v2\".cablemodems.TsCableUpstreamStatusR\x13cableUpstreamStatus\x12F\n" +
+	"\x0fofdm_downstream\x18\t \x03(\v2\x1d.cablemodems.TsOfdmDownstreamR\x0eofdmDownstreamB\f\n" +
+	"\n" +
+	"_lost_syncB\t\n" +
+	"\a_resets\"\xf4\x02\n" +
+	"\x11TsCableDownstream\x12\x1e\n" +
+	"\bif_index\x18\x01 \x01(\x05H\x00R\aifIndex\x88\x01\x01\x12(\n" +
+	"\rchannel_power\x18\x02 \x01(\tH\x01R\fchannelPower\x88\x01\x01\x12#\n" +
+	"\n" +
+	"unerroreds\x18\x03 \x01(\tH\x02R\n" +
+	"unerroreds\x88\x01\x01\x12#\n" +
+	"\n" +
+	"correcteds\x18\x04 \x01(\tH\x03R\n" +
+	"correcteds\x88\x01\x01\x12+\n" +
+	"\x0euncorrectables\x18\x05 \x01(\tH\x04R\x0euncorrectables\x88\x01\x01\x125\n" +
+	"\x14signal_noise_decibel\x18\x06 \x01(\tH\x05R\x12signalNoiseDecibel\x88\x01\x01B\v\n" +
+	"\t_if_indexB\x10\n" +
+	"\x0e_channel_powerB\r\n" +
+	"\v_unerroredsB\r\n" +
+	"\v_correctedsB\x11\n" +
+	"\x0f_uncorrectablesB\x17\n" +
+	"\x15_signal_noise_decibel\"\x93\x02\n" +
+	"\x0fTsCableUpstream\x12\x1e\n" +
+	"\bif_index\x18\x01 \x01(\x05H\x00R\aifIndex\x88\x01\x01\x12+\n" +
+	"\x0fstatus_tx_power\x18\x02 \x01(\tH\x01R\rstatusTxPower\x88\x01\x01\x121\n" +
+	"\x12status_t3_timeouts\x18\x03 \x01(\x05H\x02R\x10statusT3Timeouts\x88\x01\x01\x121\n" +
+	"\x12status_t4_timeouts\x18\x04 \x01(\x05H\x03R\x10statusT4Timeouts\x88\x01\x01B\v\n" +
+	"\t_if_indexB\x12\n" +
+	"\x10_status_tx_powerB\x15\n" +
+	"\x13_status_t3_timeoutsB\x15\n" +
+	"\x13_status_t4_timeouts\"\x90\x01\n" +
+	"\x15TsCableUpstreamStatus\x12\x1e\n" +
+	"\bif_descr\x18\x01 \x01(\tH\x00R\aifDescr\x88\x01\x01\x12\x15\n" +
+	"\x03snr\x18\x02 \x01(\tH\x01R\x03snr\x88\x01\x01\x12\x1e\n" +
+	"\brx_power\x18\x03 \x01(\tH\x02R\arxPower\x88\x01\x01B\v\n" +
+	"\t_if_descrB\x06\n" +
+	"\x04_snrB\v\n" +
+	"\t_rx_power\"\xeb\x02\n" +
+	"\x10TsOfdmDownstream\x12\x1e\n" +
+	"\bif_index\x18\x01 \x01(\x05H\x00R\aifIndex\x88\x01\x01\x12#\n" +
+	"\vrx_mer_mean\x18\x02 \x01(\tH\x01R\trxMerMean\x88\x01\x01\x126\n" +
+	"\x15rx_mer_2nd_percentile\x18\x03 \x01(\tH\x02R\x12rxMer2ndPercentile\x88\x01\x01\x12M\n" +
+	"\rprofile_stats\x18\x04 \x03(\v2(.cablemodems.TsCmOfdmChannelProfileStatsR\fprofileStats\x12T\n" +
+	"\x15ofdm_ds_channel_power\x18\x05 \x03(\v2!.cablemodems.TsCmOfdmChannelPowerR\x12ofdmDsChannelPowerB\v\n" +
+	"\t_if_indexB\x0e\n" +
+	"\f_rx_mer_meanB\x18\n" +
+	"\x16_rx_mer_2nd_percentile\"\xc5\x02\n" +
+	"\x1bTsCmOfdmChannelProfileStats\x12+\n" +
+	"\x0fcmts_profile_id\x18\x01 \x01(\x05H\x00R\rcmtsProfileId\x88\x01\x01\x124\n" +
+	"\x13corrected_codewords\x18\x02 \x01(\tH\x01R\x12correctedCodewords\x88\x01\x01\x12:\n" +
+	"\x16uncorrectable_codeword\x18\x03 \x01(\tH\x02R\x15uncorrectableCodeword\x88\x01\x01\x12,\n" +
+	"\x0ftotal_codewords\x18\x04 \x01(\tH\x03R\x0etotalCodewords\x88\x01\x01B\x12\n" +
+	"\x10_cmts_profile_idB\x16\n" +
+	"\x14_corrected_codewordsB\x19\n" +
+	"\x17_uncorrectable_codewordB\x12\n" +
+	"\x10_total_codewords\"\xd2\x01\n" +
+	"\x14TsCmOfdmChannelPower\x121\n" +
+	"\x12channel_band_index\x18\x01 \x01(\x05H\x00R\x10channelBandIndex\x88\x01\x01\x12.\n" +
+	"\x10center_frequency\x18\x02 \x01(\x05H\x01R\x0fcenterFrequency\x88\x01\x01\x12\x1e\n" +
+	"\brx_power\x18\x03 \x01(\tH\x02R\arxPower\x88\x01\x01B\x15\n" +
+	"\x13_channel_band_indexB\x13\n" +
+	"\x11_center_frequencyB\v\n" +
+	"\t_rx_power*-\n" +
 	"\x05State\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\n" +
 	"\n" +
@@ -1387,14 +2062,17 @@ const file_cablemodems_cablemodems_proto_rawDesc = "" +
 	"\x0eDOCSIS_UNKNOWN\x10\x00\x12\v\n" +
 	"\aDOCSIS3\x10\x01\x12\f\n" +
 	"\bDOCSIS31\x10\x02\x12\v\n" +
-	"\aDOCSIS4\x10\x032\xdb\x03\n" +
-	"\x11CableModemService\x12>\n" +
-	"\x05ByMac\x12\x19.cablemodems.ByMacRequest\x1a\x1a.cablemodems.ByMacResponse\x12A\n" +
-	"\x06ByCmts\x12\x1a.cablemodems.ByCmtsRequest\x1a\x1b.cablemodems.ByCmtsResponse\x12G\n" +
-	"\bByPoller\x12\x1c.cablemodems.ByPollerRequest\x1a\x1d.cablemodems.ByPollerResponse\x12>\n" +
-	"\x05Paged\x12\x19.cablemodems.PagedRequest\x1a\x1a.cablemodems.PagedResponse\x12e\n" +
-	"\x12HistoricalRegState\x12&.cablemodems.HistoricalRegStateRequest\x1a'.cablemodems.HistoricalRegStateResponse\x12S\n" +
-	"\fHistoricalCm\x12 .cablemodems.HistoricalCmRequest\x1a!.cablemodems.HistoricalCmResponseB2Z0api-project/grpc-api/gen/cablemodems;cablemodemsb\x06proto3"
+	"\aDOCSIS4\x10\x032\xaf\b\n" +
+	"\x11CableModemService\x12{\n" +
+	"\x05ByMac\x12\x19.cablemodems.ByMacRequest\x1a\x1a.cablemodems.ByMacResponse\";\x82\xd3\xe4\x93\x025Z\x1b:\x01*\"\x16/v1/cablemodems/by-mac\x12\x16/v1/cablemodems/by-mac\x12f\n" +
+	"\x06ByCmts\x12\x1a.cablemodems.ByCmtsRequest\x1a\x1b.cablemodems.ByCmtsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/cmts/{cmts}/cablemodems\x12q\n" +
+	"\bByPoller\x12\x1c.cablemodems.ByPollerRequest\x1a\x1d.cablemodems.ByPollerResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/pollers/{poller}/cablemodems\x12W\n" +
+	"\x05Paged\x12\x19.cablemodems.PagedRequest\x1a\x1a.cablemodems.PagedResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/cablemodems\x12\x96\x01\n" +
+	"\x12HistoricalRegState\x12&.cablemodems.HistoricalRegStateRequest\x1a'.cablemodems.HistoricalRegStateResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/cablemodems/historical/reg-state\x12}\n" +
+	"\fHistoricalCm\x12 .cablemodems.HistoricalCmRequest\x1a!.cablemodems.HistoricalCmResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/cablemodems/historical/cm\x12q\n" +
+	"\fStreamByCmts\x12\x1a.cablemodems.ByCmtsRequest\x1a\x17.cablemodems.CableModem\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/cmts/{cmts}/cablemodems:stream0\x01\x12z\n" +
+	"\x0eStreamByPoller\x12\x1c.cablemodems.ByPollerRequest\x1a\x17.cablemodems.CableModem\"/\x82\xd3\xe4\x93\x02)\x12'/v1/pollers/{poller}/cablemodems:stream0\x01\x12b\n" +
+	"\vStreamPaged\x12\x19.cablemodems.PagedRequest\x1a\x16.cablemodems.PagedItem\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/cablemodems:stream0\x01B2Z0api-project/grpc-api/gen/cablemodems;cablemodemsb\x06proto3"
 
 var (
 	file_cablemodems_cablemodems_proto_rawDescOnce sync.Once
@@ -1409,65 +2087,86 @@ func file_cablemodems_cablemodems_proto_rawDescGZIP() []byte {
 }
 
 var file_cablemodems_cablemodems_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cablemodems_cablemodems_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_cablemodems_cablemodems_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_cablemodems_cablemodems_proto_goTypes = []any{
-	(State)(0),                         // 0: cablemodems.State
-	(DocsisVersion)(0),                 // 1: cablemodems.DocsisVersion
-	(*ByMacRequest)(nil),               // 2: cablemodems.ByMacRequest
-	(*ByMacResponse)(nil),              // 3: cablemodems.ByMacResponse
-	(*ByCmtsRequest)(nil),              // 4: cablemodems.ByCmtsRequest
-	(*ByCmtsResponse)(nil),             // 5: cablemodems.ByCmtsResponse
-	(*ByPollerRequest)(nil),            // 6: cablemodems.ByPollerRequest
-	(*ByPollerResponse)(nil),           // 7: cablemodems.ByPollerResponse
-	(*PagedRequest)(nil),               // 8: cablemodems.PagedRequest
-	(*PagedResponse)(nil),              // 9: cablemodems.PagedResponse
-	(*HistoricalRegStateRequest)(nil),  // 10: cablemodems.HistoricalRegStateRequest
-	(*HistoricalRegStateResponse)(nil), // 11: cablemodems.HistoricalRegStateResponse
-	(*HistoricalCmRequest)(nil),        // 12: cablemodems.HistoricalCmRequest
-	(*HistoricalCmResponse)(nil),       // 13: cablemodems.HistoricalCmResponse
-	(*CableModemsFilter)(nil),          // 14: cablemodems.CableModemsFilter
-	(*CableModem)(nil),                 // 15: cablemodems.CableModem
-	(*TsRegStateDevice)(nil),           // 16: cablemodems.TsRegStateDevice
-	(*TsCmDevice)(nil),                 // 17: cablemodems.TsCmDevice
-	(*common.Error)(nil),               // 18: common.Error
+	(State)(0),                          // 0: cablemodems.State
+	(DocsisVersion)(0),                  // 1: cablemodems.DocsisVersion
+	(*ByMacRequest)(nil),                // 2: cablemodems.ByMacRequest
+	(*ByMacResponse)(nil),               // 3: cablemodems.ByMacResponse
+	(*ByCmtsRequest)(nil),               // 4: cablemodems.ByCmtsRequest
+	(*ByCmtsResponse)(nil),              // 5: cablemodems.ByCmtsResponse
+	(*ByPollerRequest)(nil),             // 6: cablemodems.ByPollerRequest
+	(*ByPollerResponse)(nil),            // 7: cablemodems.ByPollerResponse
+	(*PagedRequest)(nil),                // 8: cablemodems.PagedRequest
+	(*PagedResponse)(nil),               // 9: cablemodems.PagedResponse
+	(*PagedItem)(nil),                   // 10: cablemodems.PagedItem
+	(*HistoricalRegStateRequest)(nil),   // 11: cablemodems.HistoricalRegStateRequest
+	(*HistoricalRegStateResponse)(nil),  // 12: cablemodems.HistoricalRegStateResponse
+	(*HistoricalCmRequest)(nil),         // 13: cablemodems.HistoricalCmRequest
+	(*HistoricalCmResponse)(nil),        // 14: cablemodems.HistoricalCmResponse
+	(*CableModemsFilter)(nil),           // 15: cablemodems.CableModemsFilter
+	(*CableModem)(nil),                  // 16: cablemodems.CableModem
+	(*TsRegStateDevice)(nil),            // 17: cablemodems.TsRegStateDevice
+	(*TsCmDevice)(nil),                  // 18: cablemodems.TsCmDevice
+	(*TsCableDownstream)(nil),           // 19: cablemodems.TsCableDownstream
+	(*TsCableUpstream)(nil),             // 20: cablemodems.TsCableUpstream
+	(*TsCableUpstreamStatus)(nil),       // 21: cablemodems.TsCableUpstreamStatus
+	(*TsOfdmDownstream)(nil),            // 22: cablemodems.TsOfdmDownstream
+	(*TsCmOfdmChannelProfileStats)(nil), // 23: cablemodems.TsCmOfdmChannelProfileStats
+	(*TsCmOfdmChannelPower)(nil),        // 24: cablemodems.TsCmOfdmChannelPower
+	(*common.Error)(nil),                // 25: common.Error
 }
 var file_cablemodems_cablemodems_proto_depIdxs = []int32{
-	15, // 0: cablemodems.ByMacResponse.modems:type_name -> cablemodems.CableModem
-	18, // 1: cablemodems.ByMacResponse.error:type_name -> common.Error
+	16, // 0: cablemodems.ByMacResponse.modems:type_name -> cablemodems.CableModem
+	25, // 1: cablemodems.ByMacResponse.error:type_name -> common.Error
 	0,  // 2: cablemodems.ByCmtsRequest.state:type_name -> cablemodems.State
 	1,  // 3: cablemodems.ByCmtsRequest.docsis:type_name -> cablemodems.DocsisVersion
-	15, // 4: cablemodems.ByCmtsResponse.modems:type_name -> cablemodems.CableModem
-	18, // 5: cablemodems.ByCmtsResponse.error:type_name -> common.Error
+	16, // 4: cablemodems.ByCmtsResponse.modems:type_name -> cablemodems.CableModem
+	25, // 5: cablemodems.ByCmtsResponse.error:type_name -> common.Error
 	0,  // 6: cablemodems.ByPollerRequest.state:type_name -> cablemodems.State
 	1,  // 7: cablemodems.ByPollerRequest.docsis:type_name -> cablemodems.DocsisVersion
-	15, // 8: cablemodems.ByPollerResponse.modems:type_name -> cablemodems.CableModem
-	18, // 9: cablemodems.ByPollerResponse.error:type_name -> common.Error
-	14, // 10: cablemodems.PagedRequest.filter:type_name -> cablemodems.CableModemsFilter
-	15, // 11: cablemodems.PagedResponse.modems:type_name -> cablemodems.CableModem
-	18, // 12: cablemodems.PagedResponse.error:type_name -> common.Error
-	16, // 13: cablemodems.HistoricalRegStateResponse.devices:type_name -> cablemodems.TsRegStateDevice
-	18, // 14: cablemodems.HistoricalRegStateResponse.error:type_name -> common.Error
-	17, // 15: cablemodems.HistoricalCmResponse.devices:type_name -> cablemodems.TsCmDevice
-	18, // 16: cablemodems.HistoricalCmResponse.error:type_name -> common.Error
-	1,  // 17: cablemodems.CableModem.docsis_version:type_name -> cablemodems.DocsisVersion
-	0,  // 18: cablemodems.CableModem.state:type_name -> cablemodems.State
-	2,  // 19: cablemodems.CableModemService.ByMac:input_type -> cablemodems.ByMacRequest
-	4,  // 20: cablemodems.CableModemService.ByCmts:input_type -> cablemodems.ByCmtsRequest
-	6,  // 21: cablemodems.CableModemService.ByPoller:input_type -> cablemodems.ByPollerRequest
-	8,  // 22: cablemodems.CableModemService.Paged:input_type -> cablemodems.PagedRequest
-	10, // 23: cablemodems.CableModemService.HistoricalRegState:input_type -> cablemodems.HistoricalRegStateRequest
-	12, // 24: cablemodems.CableModemService.HistoricalCm:input_type -> cablemodems.HistoricalCmRequest
-	3,  // 25: cablemodems.CableModemService.ByMac:output_type -> cablemodems.ByMacResponse
-	5,  // 26: cablemodems.CableModemService.ByCmts:output_type -> cablemodems.ByCmtsResponse
-	7,  // 27: cablemodems.CableModemService.ByPoller:output_type -> cablemodems.ByPollerResponse
-	9,  // 28: cablemodems.CableModemService.Paged:output_type -> cablemodems.PagedResponse
-	11, // 29: cablemodems.CableModemService.HistoricalRegState:output_type -> cablemodems.HistoricalRegStateResponse
-	13, // 30: cablemodems.CableModemService.HistoricalCm:output_type -> cablemodems.HistoricalCmResponse
-	25, // [25:31] is the sub-list for method output_type
-	19, // [19:25] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	16, // 8: cablemodems.ByPollerResponse.modems:type_name -> cablemodems.CableModem
+	25, // 9: cablemodems.ByPollerResponse.error:type_name -> common.Error
+	15, // 10: cablemodems.PagedRequest.filter:type_name -> cablemodems.CableModemsFilter
+	16, // 11: cablemodems.PagedResponse.modems:type_name -> cablemodems.CableModem
+	25, // 12: cablemodems.PagedResponse.error:type_name -> common.Error
+	16, // 13: cablemodems.PagedItem.modem:type_name -> cablemodems.CableModem
+	17, // 14: cablemodems.HistoricalRegStateResponse.devices:type_name -> cablemodems.TsRegStateDevice
+	25, // 15: cablemodems.HistoricalRegStateResponse.error:type_name -> common.Error
+	18, // 16: cablemodems.HistoricalCmResponse.devices:type_name -> cablemodems.TsCmDevice
+	25, // 17: cablemodems.HistoricalCmResponse.error:type_name -> common.Error
+	1,  // 18: cablemodems.CableModemsFilter.docsis_version:type_name -> cablemodems.DocsisVersion
+	1,  // 19: cablemodems.CableModem.docsis_version:type_name -> cablemodems.DocsisVersion
+	0,  // 20: cablemodems.CableModem.state:type_name -> cablemodems.State
+	19, // 21: cablemodems.TsCmDevice.cable_downstream:type_name -> cablemodems.TsCableDownstream
+	20, // 22: cablemodems.TsCmDevice.cable_upstream:type_name -> cablemodems.TsCableUpstream
+	21, // 23: cablemodems.TsCmDevice.cable_upstream_status:type_name -> cablemodems.TsCableUpstreamStatus
+	22, // 24: cablemodems.TsCmDevice.ofdm_downstream:type_name -> cablemodems.TsOfdmDownstream
+	23, // 25: cablemodems.TsOfdmDownstream.profile_stats:type_name -> cablemodems.TsCmOfdmChannelProfileStats
+	24, // 26: cablemodems.TsOfdmDownstream.ofdm_ds_channel_power:type_name -> cablemodems.TsCmOfdmChannelPower
+	2,  // 27: cablemodems.CableModemService.ByMac:input_type -> cablemodems.ByMacRequest
+	4,  // 28: cablemodems.CableModemService.ByCmts:input_type -> cablemodems.ByCmtsRequest
+	6,  // 29: cablemodems.CableModemService.ByPoller:input_type -> cablemodems.ByPollerRequest
+	8,  // 30: cablemodems.CableModemService.Paged:input_type -> cablemodems.PagedRequest
+	11, // 31: cablemodems.CableModemService.HistoricalRegState:input_type -> cablemodems.HistoricalRegStateRequest
+	13, // 32: cablemodems.CableModemService.HistoricalCm:input_type -> cablemodems.HistoricalCmRequest
+	4,  // 33: cablemodems.CableModemService.StreamByCmts:input_type -> cablemodems.ByCmtsRequest
+	6,  // 34: cablemodems.CableModemService.StreamByPoller:input_type -> cablemodems.ByPollerRequest
+	8,  // 35: cablemodems.CableModemService.StreamPaged:input_type -> cablemodems.PagedRequest
+	3,  // 36: cablemodems.CableModemService.ByMac:output_type -> cablemodems.ByMacResponse
+	5,  // 37: cablemodems.CableModemService.ByCmts:output_type -> cablemodems.ByCmtsResponse
+	7,  // 38: cablemodems.CableModemService.ByPoller:output_type -> cablemodems.ByPollerResponse
+	9,  // 39: cablemodems.CableModemService.Paged:output_type -> cablemodems.PagedResponse
+	12, // 40: cablemodems.CableModemService.HistoricalRegState:output_type -> cablemodems.HistoricalRegStateResponse
+	14, // 41: cablemodems.CableModemService.HistoricalCm:output_type -> cablemodems.HistoricalCmResponse
+	16, // 42: cablemodems.CableModemService.StreamByCmts:output_type -> cablemodems.CableModem
+	16, // 43: cablemodems.CableModemService.StreamByPoller:output_type -> cablemodems.CableModem
+	10, // 44: cablemodems.CableModemService.StreamPaged:output_type -> cablemodems.PagedItem
+	36, // [36:45] is the sub-list for method output_type
+	27, // [27:36] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_cablemodems_cablemodems_proto_init() }
@@ -1476,13 +2175,21 @@ func file_cablemodems_cablemodems_proto_init() {
 		return
 	}
 	file_cablemodems_cablemodems_proto_msgTypes[13].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[14].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[16].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[17].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[18].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[19].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[20].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[21].OneofWrappers = []any{}
+	file_cablemodems_cablemodems_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cablemodems_cablemodems_proto_rawDesc), len(file_cablemodems_cablemodems_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,11 +25,19 @@ const (
 	CableModemService_Paged_FullMethodName              = "/cablemodems.CableModemService/Paged"
 	CableModemService_HistoricalRegState_FullMethodName = "/cablemodems.CableModemService/HistoricalRegState"
 	CableModemService_HistoricalCm_FullMethodName       = "/cablemodems.CableModemService/HistoricalCm"
+	CableModemService_StreamByCmts_FullMethodName       = "/cablemodems.CableModemService/StreamByCmts"
+	CableModemService_StreamByPoller_FullMethodName     = "/cablemodems.CableModemService/StreamByPoller"
+	CableModemService_StreamPaged_FullMethodName        = "/cablemodems.CableModemService/StreamPaged"
 )
 
 // CableModemServiceClient is the client API for CableModemService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The google.api.http options expose every RPC over HTTP/JSON through the generated grpc-gateway, with the
+// protojson field names and enum names of the messages below. Repeated and message fields of GET requests are
+// query parameters, e.g. ?macAddress=a&macAddress=b or ?filter.fqdn=x; a stream answers with one
+// {"result": ...} object per line.
 type CableModemServiceClient interface {
 	ByMac(ctx context.Context, in *ByMacRequest, opts ...grpc.CallOption) (*ByMacResponse, error)
 	ByCmts(ctx context.Context, in *ByCmtsRequest, opts ...grpc.CallOption) (*ByCmtsResponse, error)
@@ -37,6 +45,13 @@ type CableModemServiceClient interface {
 	Paged(ctx context.Context, in *PagedRequest, opts ...grpc.CallOption) (*PagedResponse, error)
 	HistoricalRegState(ctx context.Context, in *HistoricalRegStateRequest, opts ...grpc.CallOption) (*HistoricalRegStateResponse, error)
 	HistoricalCm(ctx context.Context, in *HistoricalCmRequest, opts ...grpc.CallOption) (*HistoricalCmResponse, error)
+	// The Stream* variants send every modem as soon as it is read instead of collecting the result, for CMTSs
+	// and filters that match more modems than fit in one response. A stream stops when the client cancels it.
+	StreamByCmts(ctx context.Context, in *ByCmtsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CableModem], error)
+	StreamByPoller(ctx context.Context, in *ByPollerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CableModem], error)
+	// StreamPaged sends every modem matching filter after the cursor after, in the order of Paged. first caps the
+	// number of modems sent, 0 sends all of them.
+	StreamPaged(ctx context.Context, in *PagedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PagedItem], error)
 }
 
 type cableModemServiceClient struct {
@@ -107,9 +122,71 @@ func (c *cableModemServiceClient) HistoricalCm(ctx context.Context, in *Historic
 	return out, nil
 }
 
+func (c *cableModemServiceClient) StreamByCmts(ctx context.Context, in *ByCmtsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CableModem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CableModemService_ServiceDesc.Streams[0], CableModemService_StreamByCmts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ByCmtsRequest, CableModem]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CableModemService_StreamByCmtsClient = grpc.ServerStreamingClient[CableModem]
+
+func (c *cableModemServiceClient) StreamByPoller(ctx context.Context, in *ByPollerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CableModem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CableModemService_ServiceDesc.Streams[1], CableModemService_StreamByPoller_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ByPollerRequest, CableModem]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CableModemService_StreamByPollerClient = grpc.ServerStreamingClient[CableModem]
+
+func (c *cableModemServiceClient) StreamPaged(ctx context.Context, in *PagedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PagedItem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CableModemService_ServiceDesc.Streams[2], CableModemService_StreamPaged_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PagedRequest, PagedItem]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CableModemService_StreamPagedClient = grpc.ServerStreamingClient[PagedItem]
+
 // CableModemServiceServer is the server API for CableModemService service.
 // All implementations must embed UnimplementedCableModemServiceServer
 // for forward compatibility.
+//
+// The google.api.http options expose every RPC over HTTP/JSON through the generated grpc-gateway, with the
+// protojson field names and enum names of the messages below. Repeated and message fields of GET requests are
+// query parameters, e.g. ?macAddress=a&macAddress=b or ?filter.fqdn=x; a stream answers with one
+// {"result": ...} object per line.
 type CableModemServiceServer interface {
 	ByMac(context.Context, *ByMacRequest) (*ByMacResponse, error)
 	ByCmts(context.Context, *ByCmtsRequest) (*ByCmtsResponse, error)
@@ -117,6 +194,13 @@ type CableModemServiceServer interface {
 	Paged(context.Context, *PagedRequest) (*PagedResponse, error)
 	HistoricalRegState(context.Context, *HistoricalRegStateRequest) (*HistoricalRegStateResponse, error)
 	HistoricalCm(context.Context, *HistoricalCmRequest) (*HistoricalCmResponse, error)
+	// The Stream* variants send every modem as soon as it is read instead of collecting the result, for CMTSs
+	// and filters that match more modems than fit in one response. A stream stops when the client cancels it.
+	StreamByCmts(*ByCmtsRequest, grpc.ServerStreamingServer[CableModem]) error
+	StreamByPoller(*ByPollerRequest, grpc.ServerStreamingServer[CableModem]) error
+	// StreamPaged sends every modem matching filter after the cursor after, in the order of Paged. first caps the
+	// number of modems sent, 0 sends all of them.
+	StreamPaged(*PagedRequest, grpc.ServerStreamingServer[PagedItem]) error
 	mustEmbedUnimplementedCableModemServiceServer()
 }
 
@@ -145,6 +229,15 @@ func (UnimplementedCableModemServiceServer) HistoricalRegState(context.Context,
 func (UnimplementedCableModemServiceServer) HistoricalCm(context.Context, *HistoricalCmRequest) (*HistoricalCmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalCm not implemented")
 }
+func (UnimplementedCableModemServiceServer) StreamByCmts(*ByCmtsRequest, grpc.ServerStreamingServer[CableModem]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamByCmts not implemented")
+}
+func (UnimplementedCableModemServiceServer) StreamByPoller(*ByPollerRequest, grpc.ServerStreamingServer[CableModem]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamByPoller not implemented")
+}
+func (UnimplementedCableModemServiceServer) StreamPaged(*PagedRequest, grpc.ServerStreamingServer[PagedItem]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPaged not implemented")
+}
 func (UnimplementedCableModemServiceServer) mustEmbedUnimplementedCableModemServiceServer() {}
 func (UnimplementedCableModemServiceServer) testEmbeddedByValue()                           {}
 
@@ -274,6 +367,39 @@ func _CableModemService_HistoricalCm_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CableModemService_StreamByCmts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ByCmtsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CableModemServiceServer).StreamByCmts(m, &grpc.GenericServerStream[ByCmtsRequest, CableModem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CableModemService_StreamByCmtsServer = grpc.ServerStreamingServer[CableModem]
+
+func _CableModemService_StreamByPoller_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ByPollerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CableModemServiceServer).StreamByPoller(m, &grpc.GenericServerStream[ByPollerRequest, CableModem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CableModemService_StreamByPollerServer = grpc.ServerStreamingServer[CableModem]
+
+func _CableModemService_StreamPaged_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PagedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CableModemServiceServer).StreamPaged(m, &grpc.GenericServerStream[PagedRequest, PagedItem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CableModemService_StreamPagedServer = grpc.ServerStreamingServer[PagedItem]
+
 // CableModemService_ServiceDesc is the grpc.ServiceDesc for CableModemService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -306,6 +432,22 @@ var CableModemService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CableModemService_HistoricalCm_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamByCmts",
+			Handler:       _CableModemService_StreamByCmts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamByPoller",
+			Handler:       _CableModemService_StreamByPoller_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPaged",
+			Handler:       _CableModemService_StreamPaged_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "cablemodems/cablemodems.proto",
 }
@@ -0,0 +1,49 @@
+// Package healthcheck keeps the serving status of the standard grpc.health.v1.Health service in step with the
+// database, so that an orchestrator stops routing to a server that cannot answer queries.
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Watch sets services on srv to SERVING while check succeeds and to NOT_SERVING while it fails. It checks right
+// away and then every interval, each check bounded by interval, until ctx is done. The empty service name is
+// the status of the whole server.
+func Watch(ctx context.Context, srv *health.Server, check func(context.Context) error, interval time.Duration, services ...string) {
+	var serving *bool
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		err := check(checkCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		for _, s := range services {
+			srv.SetServingStatus(s, status)
+		}
+		if ok := err == nil; serving == nil || *serving != ok {
+			if ok {
+				log.Info().Strs("services", services).Msg("grpc health: serving")
+			} else {
+				log.Warn().Err(err).Strs("services", services).Msg("grpc health: not serving")
+			}
+			serving = &ok
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestMain(m *testing.M) {
+	log.Logger = zerolog.New(io.Discard)
+	os.Exit(m.Run())
+}
+
+func TestWatchFollowsCheck(t *testing.T) {
+	t.Parallel()
+	srv := health.NewServer()
+	var down atomic.Bool
+	check := func(context.Context) error {
+		if down.Load() {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		Watch(ctx, srv, check, time.Millisecond, "", "cablemodems.CableModemService")
+		close(done)
+	}()
+
+	waitFor := func(want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for {
+			resp, err := srv.Check(ctx, &healthpb.HealthCheckRequest{Service: "cablemodems.CableModemService"})
+			whole, _ := srv.Check(ctx, &healthpb.HealthCheckRequest{})
+			if err == nil && resp.Status == want && whole.GetStatus() == want {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("got %v, want %v", resp, want)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	waitFor(healthpb.HealthCheckResponse_SERVING)
+	down.Store(true)
+	waitFor(healthpb.HealthCheckResponse_NOT_SERVING)
+	down.Store(false)
+	waitFor(healthpb.HealthCheckResponse_SERVING)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Watch did not return once ctx was done")
+	}
+}
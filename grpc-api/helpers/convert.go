@@ -0,0 +1,212 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"api-project/grpc-api/gen/cablemodems"
+	cmrepo "api-project/pkg/cablemodems"
+)
+
+// CableModemsToProto converts repository results into their protobuf representation. Modems whose enum values
+// cannot be converted are still returned, and the error lists them.
+func CableModemsToProto(modems []*cmrepo.CableModem) ([]*cablemodems.CableModem, error) {
+	out := make([]*cablemodems.CableModem, len(modems))
+	var errs []error
+	for i, m := range modems {
+		var err error
+		if out[i], err = CableModemToProto(m); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+// CableModemToProto converts a single repository result into its protobuf representation.
+// Enum values that cannot be parsed are reported as UNKNOWN rather than failing the whole response; the error
+// names them so that the caller can report a partial failure.
+func CableModemToProto(m *cmrepo.CableModem) (*cablemodems.CableModem, error) {
+	out := &cablemodems.CableModem{
+		Mac:                m.Mac,
+		CpeMac:             m.CpeMac,
+		MacDomain:          m.MacDomain,
+		CableModemIndex:    m.CableModemIndex,
+		ConfigFile:         m.ConfigFile,
+		Model:              m.Model,
+		FiberNode:          m.FiberNode,
+		Ipv4:               m.Ipv4,
+		Ipv6:               m.Ipv6,
+		CpeIpv4:            m.CpeIpv4,
+		Transponder:        m.Transponder,
+		Ppod:               m.Ppod,
+		Fqdn:               m.Fqdn,
+		NotFoundDate:       m.NotFoundDate,
+		RegState:           m.RegState,
+		FnName:             m.FnName,
+		NumberOfGenerators: m.NumberOfGenerators,
+		RpdName:            m.RpdName,
+		UpdatedAt:          m.UpdatedAt,
+		Bootr:              m.Bootr,
+		Vendor:             m.Vendor,
+		SwRev:              m.SwRev,
+		OltName:            m.OltName,
+		PonName:            m.PonName,
+		IsCpe:              m.IsCpe,
+		CmtsType:           m.CmtsType,
+		DeviceType:         m.DeviceType,
+	}
+	if m.UpdatedAtTs != nil {
+		ts := int64(*m.UpdatedAtTs)
+		out.UpdatedAtTs = &ts
+	}
+	var errs []error
+	if m.DocsisVersion != nil {
+		d, err := ParseDocsisVersionFromString(string(*m.DocsisVersion))
+		if err != nil {
+			errs = append(errs, err)
+		}
+		out.DocsisVersion = &d
+	}
+	if m.State != nil {
+		s, err := ParseStateFromString(string(*m.State))
+		if err != nil {
+			errs = append(errs, err)
+		}
+		out.State = &s
+	}
+	if err := errors.Join(errs...); err != nil {
+		return out, fmt.Errorf("cable modem %s: %w", m.Mac, err)
+	}
+	return out, nil
+}
+
+// StateToDomain converts a request State for the repository. UNKNOWN means the filter was not set.
+func StateToDomain(s cablemodems.State) *cmrepo.State {
+	if s == cablemodems.State_UNKNOWN {
+		return nil
+	}
+	out := cmrepo.State(StateToString(s))
+	return &out
+}
+
+// DocsisVersionToDomain converts a request DocsisVersion for the repository. DOCSIS_UNKNOWN means the filter was not set.
+func DocsisVersionToDomain(d cablemodems.DocsisVersion) *cmrepo.DocsisVersion {
+	if d == cablemodems.DocsisVersion_DOCSIS_UNKNOWN {
+		return nil
+	}
+	out := cmrepo.DocsisVersion(DocsisVersionToString(d))
+	return &out
+}
+
+// FilterToDomain converts a Paged filter for the repository. Empty fields are not set.
+func FilterToDomain(f *cablemodems.CableModemsFilter) *cmrepo.Filter {
+	if f == nil {
+		return nil
+	}
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+	out := &cmrepo.Filter{
+		DocsisVersion: DocsisVersionToDomain(f.DocsisVersion),
+		DsInterface:   optional(f.DsInterface),
+		Fqdn:          optional(f.Fqdn),
+		Ppod:          optional(f.PpodName),
+		FiberNode:     optional(f.FiberNode),
+		Transponder:   f.Transponder,
+		MacDomain:     optional(f.MacDomain),
+	}
+	if len(f.MacAddress) > 0 {
+		out.MacAddress = &cmrepo.StringFilterEqIn{In: f.MacAddress}
+	}
+	return out
+}
+
+// TsRegStateDevicesToProto converts historical reg states. The proto carries the reg state as a string.
+func TsRegStateDevicesToProto(devices []*cmrepo.TsRegStateDevice) []*cablemodems.TsRegStateDevice {
+	out := make([]*cablemodems.TsRegStateDevice, len(devices))
+	for i, d := range devices {
+		p := &cablemodems.TsRegStateDevice{}
+		if d.Mac != nil {
+			p.Mac = *d.Mac
+		}
+		if d.RegState != nil {
+			p.RegState = strconv.Itoa(int(*d.RegState))
+		}
+		if d.Time != nil {
+			p.Timestamp = int64(*d.Time)
+		}
+		out[i] = p
+	}
+	return out
+}
+
+// TsCmDevicesToProto converts historical channel samples.
+func TsCmDevicesToProto(devices []*cmrepo.TsCmDevice) []*cablemodems.TsCmDevice {
+	out := make([]*cablemodems.TsCmDevice, len(devices))
+	for i, d := range devices {
+		p := &cablemodems.TsCmDevice{
+			LostSync: d.LostSync,
+			Resets:   d.Resets,
+		}
+		if d.Mac != nil {
+			p.Mac = *d.Mac
+		}
+		if d.Time != nil {
+			p.Timestamp = int64(*d.Time)
+		}
+		for _, c := range d.CableDownstream {
+			p.CableDownstream = append(p.CableDownstream, &cablemodems.TsCableDownstream{
+				IfIndex:            c.IfIndex,
+				ChannelPower:       c.ChannelPower,
+				Unerroreds:         c.Unerroreds,
+				Correcteds:         c.Correcteds,
+				Uncorrectables:     c.Uncorrectables,
+				SignalNoiseDecibel: c.SignalNoiseDecibel,
+			})
+		}
+		for _, c := range d.CableUpstream {
+			p.CableUpstream = append(p.CableUpstream, &cablemodems.TsCableUpstream{
+				IfIndex:          c.IfIndex,
+				StatusTxPower:    c.StatusTxPower,
+				StatusT3Timeouts: c.StatusT3Timeouts,
+				StatusT4Timeouts: c.StatusT4Timeouts,
+			})
+		}
+		for _, c := range d.CableUpstreamStatus {
+			p.CableUpstreamStatus = append(p.CableUpstreamStatus, &cablemodems.TsCableUpstreamStatus{
+				IfDescr: c.IfDescr,
+				Snr:     c.Snr,
+				RxPower: c.RxPower,
+			})
+		}
+		for _, c := range d.OfdmDownstream {
+			ofdm := &cablemodems.TsOfdmDownstream{
+				IfIndex:             c.IfIndex,
+				RxMerMean:           c.RxMerMean,
+				RxMer_2NdPercentile: c.RxMer2ndPercentile,
+			}
+			for _, s := range c.ProfileStats {
+				ofdm.ProfileStats = append(ofdm.ProfileStats, &cablemodems.TsCmOfdmChannelProfileStats{
+					CmtsProfileId:         s.CmtsProfileID,
+					CorrectedCodewords:    s.CorrectedCodewords,
+					UncorrectableCodeword: s.UncorrectableCodeword,
+					TotalCodewords:        s.TotalCodewords,
+				})
+			}
+			for _, pw := range c.OfdmDsChannelPower {
+				ofdm.OfdmDsChannelPower = append(ofdm.OfdmDsChannelPower, &cablemodems.TsCmOfdmChannelPower{
+					ChannelBandIndex: pw.ChannelBandIndex,
+					CenterFrequency:  pw.CenterFrequency,
+					RxPower:          pw.RxPower,
+				})
+			}
+			p.OfdmDownstream = append(p.OfdmDownstream, ofdm)
+		}
+		out[i] = p
+	}
+	return out
+}
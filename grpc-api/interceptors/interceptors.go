@@ -0,0 +1,53 @@
+// Package interceptors wraps every call the gRPC server handles: it tags the call with a request ID, gives it a
+// read-your-writes session, recovers handler panics, logs the call with zerolog and records per-method metrics on
+// /debug/vars.
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ServerOptions installs the unary and stream interceptor chains. The request ID is set first so that every
+// later interceptor can log it, and recovery runs closest to the handler so that a panic is logged and counted
+// as the codes.Internal it becomes.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryRequestID, UnarySession, UnaryObserve, UnaryRecovery),
+		grpc.ChainStreamInterceptor(StreamRequestID, StreamSession, StreamObserve, StreamRecovery),
+	}
+}
+
+// serverStream overrides the context of a stream and adds up the size of the messages it receives.
+type serverStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received int
+}
+
+func wrapStream(ss grpc.ServerStream) *serverStream {
+	if s, ok := ss.(*serverStream); ok {
+		return s
+	}
+	return &serverStream{ServerStream: ss, ctx: ss.Context()}
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func (s *serverStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received += messageSize(m)
+	}
+	return err
+}
+
+// messageSize is the encoded size of a protobuf message, 0 for anything else.
+func messageSize(m any) int {
+	if msg, ok := m.(proto.Message); ok {
+		return proto.Size(msg)
+	}
+	return 0
+}
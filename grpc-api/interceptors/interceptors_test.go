@@ -0,0 +1,211 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"expvar"
+	"net"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"api-project/grpc-api/gen/cablemodems"
+	"api-project/grpc-api/methods"
+	cmrepo "api-project/pkg/cablemodems"
+	"api-project/pkg/dbservice"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// panickingStore panics on every poller lookup.
+type panickingStore struct {
+	cmrepo.Store
+}
+
+func (panickingStore) ByPoller(context.Context, cmrepo.PollerQuery) ([]*cmrepo.CableModem, error) {
+	panic("boom")
+}
+
+// newTestClient serves the shared modem fixture through the interceptor chain over an in-memory connection.
+func newTestClient(t *testing.T) cablemodems.CableModemServiceClient {
+	t.Helper()
+	modems, err := cmrepo.LoadFixture("../../pkg/cablemodems/testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(ServerOptions()...)
+	cablemodems.RegisterCableModemServiceServer(srv, &methods.CableModemMethod{Repo: panickingStore{cmrepo.NewMemory(modems)}})
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return cablemodems.NewCableModemServiceClient(conn)
+}
+
+// captureLog sends the global logger to a buffer for the rest of the test.
+func captureLog(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	logger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() { log.Logger = logger })
+	return &buf
+}
+
+// logLines decodes the JSON log lines written so far.
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var lines []map[string]interface{}
+	for _, l := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var line map[string]interface{}
+		if err := json.Unmarshal([]byte(l), &line); err != nil {
+			t.Fatalf("%q: %v", l, err)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestRequestID(t *testing.T) {
+	client := newTestClient(t)
+	buf := captureLog(t)
+
+	var header metadata.MD
+	ctx := metadata.AppendToOutgoingContext(context.Background(), RequestIDKey, "poller-42")
+	if _, err := client.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"5c:22:da:0e:9f:01"}}, grpc.Header(&header)); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get(RequestIDKey); len(got) != 1 || got[0] != "poller-42" {
+		t.Errorf("propagated request ID: got %v", got)
+	}
+
+	// a missing or unloggable ID is replaced by a generated one.
+	for _, sent := range []string{"", "has space", strings.Repeat("x", 129)} {
+		ctx := context.Background()
+		if sent != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDKey, sent)
+		}
+		if _, err := client.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"5c:22:da:0e:9f:01"}}, grpc.Header(&header)); err != nil {
+			t.Fatal(err)
+		}
+		if got := header.Get(RequestIDKey); len(got) != 1 || !regexp.MustCompile(`^[0-9a-f]{32}$`).MatchString(got[0]) {
+			t.Errorf("sent %q: got request ID %v", sent, got)
+		}
+	}
+
+	stream, err := client.StreamByCmts(ctx, &cablemodems.ByCmtsRequest{Cmts: "den01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header, err = stream.Header(); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get(RequestIDKey); len(got) != 1 || got[0] != "poller-42" {
+		t.Errorf("stream request ID: got %v", got)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+	}
+
+	lines := logLines(t, buf)
+	if first := lines[0]; first["request_id"] != "poller-42" || first["method"] != cablemodems.CableModemService_ByMac_FullMethodName ||
+		first["code"] != "OK" || first["peer"] == "" || first["request_size"] != float64(19) || first["duration"] == nil {
+		t.Errorf("got log line %v", first)
+	}
+}
+
+func TestRecoveryAndMetrics(t *testing.T) {
+	client := newTestClient(t)
+	buf := captureLog(t)
+	ctx := context.Background()
+	method := cablemodems.CableModemService_ByPoller_FullMethodName
+	before := expvarInt(calls, method)
+
+	_, err := client.ByPoller(ctx, &cablemodems.ByPollerRequest{Poller: "RX_MER", Cmts: "den01"})
+	if status.Code(err) != codes.Internal || strings.Contains(err.Error(), "boom") {
+		t.Fatalf("got %v, want Internal without the panic value", err)
+	}
+	// the server survives the panic.
+	if _, err := client.ByPoller(ctx, &cablemodems.ByPollerRequest{Poller: "SNMP", Cmts: "den01"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got %v", err)
+	}
+
+	if got := expvarInt(calls, method) - before; got != 2 {
+		t.Errorf("counted %d calls, want 2", got)
+	}
+	if expvarInt(panics, method) == 0 || expvarInt(failures, method+"/Internal") == 0 || expvarInt(failures, method+"/InvalidArgument") == 0 {
+		t.Errorf("errors not counted: %s %s", panics, failures)
+	}
+	var h struct {
+		Count   int64
+		Buckets []struct {
+			Le    string
+			Count int64
+		}
+	}
+	if err := json.Unmarshal([]byte(latency.Get(method).String()), &h); err != nil {
+		t.Fatal(err)
+	}
+	if last := h.Buckets[len(h.Buckets)-1]; h.Count < 2 || last.Le != "+Inf" || last.Count != h.Count {
+		t.Errorf("latency histogram: %+v", h)
+	}
+
+	var levels []interface{}
+	for _, l := range logLines(t, buf) {
+		levels = append(levels, l["level"])
+		if l["message"] == "grpc handler panicked" && (l["panic"] != "boom" || l["stack"] == nil) {
+			t.Errorf("panic log: %v", l)
+		}
+	}
+	if want := []interface{}{"error", "error", "warn"}; !reflect.DeepEqual(levels, want) {
+		t.Errorf("log levels %v, want %v", levels, want)
+	}
+}
+
+func expvarInt(m *expvar.Map, key string) int64 {
+	if v, ok := m.Get(key).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestSession(t *testing.T) {
+	t.Parallel()
+	at := time.Unix(1760000000, 0)
+	for _, tt := range []struct {
+		md   metadata.MD
+		want time.Time
+	}{
+		{metadata.Pairs(LastWriteKey, dbservice.FormatLastWrite(at)), at},
+		{metadata.Pairs(LastWriteKey, "nope"), time.Time{}},
+		{nil, time.Time{}},
+	} {
+		ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+		var got time.Time
+		_, err := UnarySession(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+			got = dbservice.LastWrite(ctx)
+			return nil, nil
+		})
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("%v: got %v %v, want %v", tt.md, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package interceptors
+
+import (
+	"expvar"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// The metrics of every method, served on /debug/vars: calls counts the calls of each method, failures counts
+// failed calls by "method/code", panics counts recovered panics and latency holds a histogram of each
+// method's duration in milliseconds.
+var (
+	calls    = expvar.NewMap("grpc_calls")
+	failures = expvar.NewMap("grpc_errors")
+	panics   = expvar.NewMap("grpc_panics")
+	latency  = expvar.NewMap("grpc_latency_ms")
+)
+
+// latencyBuckets are the upper bounds, in milliseconds, of the latency histogram buckets. Streams that run for
+// longer than the last one land in the +Inf bucket.
+var latencyBuckets = []float64{1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// histogramsMu makes creating the histogram of a method atomic; expvar.Map has no LoadOrStore.
+var histogramsMu sync.Mutex
+
+func record(method string, d time.Duration, code codes.Code) {
+	calls.Add(method, 1)
+	if code != codes.OK {
+		failures.Add(method+"/"+code.String(), 1)
+	}
+	histogramOf(method).observe(d)
+}
+
+func histogramOf(method string) *histogram {
+	if h, ok := latency.Get(method).(*histogram); ok {
+		return h
+	}
+	histogramsMu.Lock()
+	defer histogramsMu.Unlock()
+	if h, ok := latency.Get(method).(*histogram); ok {
+		return h
+	}
+	h := &histogram{buckets: make([]atomic.Int64, len(latencyBuckets)+1)}
+	latency.Set(method, h)
+	return h
+}
+
+// histogram counts durations in latencyBuckets. It is an expvar.Var whose JSON has the total count, the sum
+// in milliseconds and the cumulative count of every bucket, like a Prometheus histogram.
+type histogram struct {
+	count   atomic.Int64
+	sumUs   atomic.Int64
+	buckets []atomic.Int64 // not cumulative; the last one is +Inf
+}
+
+func (h *histogram) observe(d time.Duration) {
+	ms := float64(d) / float64(time.Millisecond)
+	i := 0
+	for i < len(latencyBuckets) && ms > latencyBuckets[i] {
+		i++
+	}
+	h.buckets[i].Add(1)
+	h.count.Add(1)
+	h.sumUs.Add(d.Microseconds())
+}
+
+func (h *histogram) String() string {
+	var b strings.Builder
+	b.WriteString(`{"count":`)
+	b.WriteString(strconv.FormatInt(h.count.Load(), 10))
+	b.WriteString(`,"sum":`)
+	b.WriteString(strconv.FormatFloat(float64(h.sumUs.Load())/1000, 'f', -1, 64))
+	b.WriteString(`,"buckets":[`)
+	var cumulative int64
+	for i := range h.buckets {
+		cumulative += h.buckets[i].Load()
+		le := "+Inf"
+		if i < len(latencyBuckets) {
+			le = strconv.FormatFloat(latencyBuckets[i], 'f', -1, 64)
+		}
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(`{"le":"` + le + `","count":` + strconv.FormatInt(cumulative, 10) + `}`)
+	}
+	b.WriteString("]}")
+	return b.String()
+}
@@ -0,0 +1,72 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"api-project/grpc-api/tlsconfig"
+)
+
+// UnaryObserve logs a unary call and records its metrics.
+func UnaryObserve(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observe(ctx, info.FullMethod, time.Since(start), messageSize(req), err)
+	return resp, err
+}
+
+// StreamObserve logs a streaming call once it ends and records its metrics. The request size is the total of
+// the messages the client sent.
+func StreamObserve(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	s := wrapStream(ss)
+	start := time.Now()
+	err := handler(srv, s)
+	observe(s.ctx, info.FullMethod, time.Since(start), s.received, err)
+	return err
+}
+
+// healthMethods prefixes the methods of the grpc.health.v1.Health service.
+const healthMethods = "/grpc.health.v1.Health/"
+
+func observe(ctx context.Context, method string, d time.Duration, size int, err error) {
+	code := status.Code(err)
+	record(method, d, code)
+
+	var ev *zerolog.Event
+	switch {
+	case code == codes.OK && strings.HasPrefix(method, healthMethods):
+		// probed every few seconds by the orchestrator.
+		ev = log.Debug()
+	case code == codes.OK:
+		ev = log.Info()
+	case code == codes.Internal, code == codes.Unknown, code == codes.DataLoss:
+		ev = log.Error().Err(err)
+	default:
+		ev = log.Warn().Err(err)
+	}
+	if subject, ok := tlsconfig.ClientSubject(ctx); ok {
+		ev = ev.Str("client", subject.String())
+	}
+	ev.Str("method", method).
+		Str("peer", peerAddr(ctx)).
+		Str("request_id", RequestID(ctx)).
+		Dur("duration", d).
+		Str("code", code.String()).
+		Int("request_size", size).
+		Msg("grpc call")
+}
+
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
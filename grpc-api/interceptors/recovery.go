@@ -0,0 +1,44 @@
+package interceptors
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryRecovery turns a panic in a unary handler into codes.Internal instead of crashing the server.
+func UnaryRecovery(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// StreamRecovery turns a panic in a stream handler into codes.Internal instead of crashing the server.
+func StreamRecovery(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// recovered logs a panic with its stack and returns the status the client gets. The panic value stays in the
+// log: it may hold anything, including data the client should not see.
+func recovered(ctx context.Context, method string, r any) error {
+	panics.Add(method, 1)
+	log.Error().
+		Str("method", method).
+		Str("request_id", RequestID(ctx)).
+		Interface("panic", r).
+		Bytes("stack", debug.Stack()).
+		Msg("grpc handler panicked")
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,75 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDKey is the metadata key of the request ID. A client may set it to correlate its own logs with ours;
+// the server sends the ID it used back in the response header either way.
+const RequestIDKey = "x-request-id"
+
+// maxRequestIDLen bounds a client supplied request ID, which ends up in every log line of the call.
+const maxRequestIDLen = 128
+
+type requestIDKey struct{}
+
+// RequestID returns the request ID of the call ctx belongs to, or "" outside of one.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// withRequestID returns ctx carrying the request ID the client sent, or a new one if it sent none or one that
+// cannot be logged as is.
+func withRequestID(ctx context.Context) (context.Context, string) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDKey); len(values) > 0 && validRequestID(values[0]) {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = newRequestID()
+	}
+	return context.WithValue(ctx, requestIDKey{}, id), id
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// UnaryRequestID sets the request ID of a unary call.
+func UnaryRequestID(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, id := withRequestID(ctx)
+	// fails only outside of a real call, as in tests.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id))
+	return handler(ctx, req)
+}
+
+// StreamRequestID sets the request ID of a streaming call.
+func StreamRequestID(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	s := wrapStream(ss)
+	var id string
+	s.ctx, id = withRequestID(s.ctx)
+	_ = s.SetHeader(metadata.Pairs(RequestIDKey, id))
+	return handler(srv, s)
+}
@@ -0,0 +1,38 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"api-project/pkg/dbservice"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// LastWriteKey is the metadata key of dbservice.LastWriteHeader. A client that wrote through another API sends
+// back the value it got there, so that its reads within the read-your-writes window go to the primary.
+var LastWriteKey = strings.ToLower(dbservice.LastWriteHeader)
+
+// withSession returns ctx carrying a read-your-writes session seeded from the metadata the client sent.
+func withSession(ctx context.Context) context.Context {
+	var v string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(LastWriteKey); len(values) > 0 {
+			v = values[0]
+		}
+	}
+	return dbservice.WithSession(ctx, dbservice.ParseLastWrite(v))
+}
+
+// UnarySession gives a unary call its read-your-writes session.
+func UnarySession(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(withSession(ctx), req)
+}
+
+// StreamSession gives a streaming call its read-your-writes session.
+func StreamSession(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	s := wrapStream(ss)
+	s.ctx = withSession(s.ctx)
+	return handler(srv, s)
+}
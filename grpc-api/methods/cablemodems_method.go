@@ -2,12 +2,12 @@ package methods
 
 import (
 	"context"
-	"database/sql"
-	"fmt"
-	"strings"
+	"errors"
+	"time"
 
 	"api-project/grpc-api/gen/cablemodems"
 	"api-project/grpc-api/helpers"
+	cmrepo "api-project/pkg/cablemodems"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,109 +16,231 @@ import (
 // CableModemMethod 实现 CableModemServiceServer 接口
 type CableModemMethod struct {
 	cablemodems.UnimplementedCableModemServiceServer
-	Db *sql.DB
+	Repo cmrepo.Store
 }
 
-// 示例实现：GetCableModem
+// repoError 把仓库返回的错误映射为 gRPC status
+func repoError(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, cmrepo.ErrNotReady):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, cmrepo.ErrInvalidCursor),
+		errors.Is(err, cmrepo.ErrInvalidFirst),
+		errors.Is(err, cmrepo.ErrInvalidTimeRange),
+		errors.Is(err, cmrepo.ErrDuplicateMac):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "query error: %v", err)
+	}
+}
+
+// ByMac 按 mac 地址查询 cable modem。非法和没有查到的 mac 不影响其余结果，作为部分失败写入 error
 func (h *CableModemMethod) ByMac(ctx context.Context, req *cablemodems.ByMacRequest) (*cablemodems.ByMacResponse, error) {
-	if h.Db == nil {
-		return nil, status.Error(codes.Internal, "database connection is nil")
+	if h.Repo == nil {
+		return nil, status.Error(codes.Internal, "cable modem repository is nil")
 	}
 	if len(req.MacAddress) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "macAddress list is empty")
 	}
 
-	// 构建查询语句
-	placeholders := make([]string, len(req.MacAddress))
-	args := make([]interface{}, len(req.MacAddress))
-	for i, mac := range req.MacAddress {
-		placeholders[i] = fmt.Sprintf("$%d", i+1)
-		args[i] = mac
+	var failed partial
+	macs, err := validMacs(req.MacAddress, &failed)
+	if err != nil {
+		return nil, err
 	}
 
-	query := fmt.Sprintf(`
-		SELECT mac, cpe_mac, mac_domain, cable_modem_index, config_file, model,
-		       fiber_node, ipv4, ipv6, cpe_ipv4, transponder, docsis_version,
-		       ppod, fqdn, state, not_found_date, reg_state, fn_name,
-		       number_of_generators, rpd_name, updated_at, bootr, vendor,
-		       sw_rev, olt_name, pon_name, updated_at_ts, is_cpe, cmts_type, device_type
-		FROM cablemodems
-		WHERE mac IN (%s)
-	`, strings.Join(placeholders, ", "))
-
-	rows, err := h.Db.QueryContext(ctx, query, args...)
+	modems, err := h.Repo.ByMac(ctx, macs)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "query error: %v", err)
-	}
-	defer rows.Close()
-
-	var modems []*cablemodems.CableModem
-
-	for rows.Next() {
-		var (
-			m         cablemodems.CableModem
-			stateStr  string
-			docsisStr string
-		)
-
-		err := rows.Scan(
-			&m.Mac,
-			&m.CpeMac,
-			&m.MacDomain,
-			&m.CableModemIndex,
-			&m.ConfigFile,
-			&m.Model,
-			&m.FiberNode,
-			&m.Ipv4,
-			&m.Ipv6,
-			&m.CpeIpv4,
-			&m.Transponder,
-			&docsisStr,
-			&m.Ppod,
-			&m.Fqdn,
-			&stateStr,
-			&m.NotFoundDate,
-			&m.RegState,
-			&m.FnName,
-			&m.NumberOfGenerators,
-			&m.RpdName,
-			&m.UpdatedAt,
-			&m.Bootr,
-			&m.Vendor,
-			&m.SwRev,
-			&m.OltName,
-			&m.PonName,
-			&m.UpdatedAtTs,
-			&m.IsCpe,
-			&m.CmtsType,
-			&m.DeviceType,
-		)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "row scan error: %v", err)
-		}
-
-		stateEnum, err := helpers.ParseStateFromString(stateStr)
-		if err != nil {
-			stateEnum = cablemodems.State_UNKNOWN
-		}
-
-		docsisEnum, err := helpers.ParseDocsisVersionFromString(docsisStr)
-		if err != nil {
-			docsisEnum = cablemodems.DocsisVersion_DOCSIS_UNKNOWN
-		}
-
-		m.State = &stateEnum
-		m.DocsisVersion = &docsisEnum
-
-		modems = append(modems, &m)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, status.Errorf(codes.Internal, "rows iteration error: %v", err)
+		return nil, repoError(err)
 	}
+	found := make([]string, len(modems))
+	for i, m := range modems {
+		found[i] = m.Mac
+	}
+	missingMacs(macs, found, &failed)
 
+	out, err := helpers.CableModemsToProto(modems)
+	failed.addErr(codes.Internal, err)
 	return &cablemodems.ByMacResponse{
-		Modems: modems,
-		Error:  nil,
+		Modems: out,
+		Error:  failed.proto(),
+	}, nil
+}
+
+// ByCmts 查询某个 CMTS（fqdn 或 ppod）下的 cable modem
+func (h *CableModemMethod) ByCmts(ctx context.Context, req *cablemodems.ByCmtsRequest) (*cablemodems.ByCmtsResponse, error) {
+	if h.Repo == nil {
+		return nil, status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	q, err := cmtsQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	modems, err := h.Repo.ByCmts(ctx, q)
+	if err != nil {
+		return nil, repoError(err)
+	}
+
+	var failed partial
+	out, err := helpers.CableModemsToProto(modems)
+	failed.addErr(codes.Internal, err)
+	return &cablemodems.ByCmtsResponse{
+		Modems: out,
+		Error:  failed.proto(),
+	}, nil
+}
+
+// ByPoller 查询某个 poller 在 CMTS 上需要轮询的 cable modem
+func (h *CableModemMethod) ByPoller(ctx context.Context, req *cablemodems.ByPollerRequest) (*cablemodems.ByPollerResponse, error) {
+	if h.Repo == nil {
+		return nil, status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	q, err := pollerQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	modems, err := h.Repo.ByPoller(ctx, q)
+	if err != nil {
+		return nil, repoError(err)
+	}
+
+	var failed partial
+	out, err := helpers.CableModemsToProto(modems)
+	failed.addErr(codes.Internal, err)
+	return &cablemodems.ByPollerResponse{
+		Modems: out,
+		Error:  failed.proto(),
+	}, nil
+}
+
+// Paged 按 filter 分页查询 cable modem，after 为上一页返回的 next_cursor
+func (h *CableModemMethod) Paged(ctx context.Context, req *cablemodems.PagedRequest) (*cablemodems.PagedResponse, error) {
+	if h.Repo == nil {
+		return nil, status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	first := int(req.First)
+	if first == 0 {
+		first = cmrepo.DefaultPageSize
+	}
+
+	filter, err := pagedFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.Repo.Paged(ctx, filter, first, req.After)
+	if err != nil {
+		return nil, repoError(err)
+	}
+
+	var failed partial
+	out, err := helpers.CableModemsToProto(page.Modems)
+	failed.addErr(codes.Internal, err)
+	return &cablemodems.PagedResponse{
+		Modems:      out,
+		NextCursor:  page.EndCursor,
+		HasNextPage: page.HasNextPage,
+		Error:       failed.proto(),
+	}, nil
+}
+
+// cmtsQuery 校验 ByCmts 请求并转换为仓库查询，ByCmts 和 StreamByCmts 共用
+func cmtsQuery(req *cablemodems.ByCmtsRequest) (cmrepo.CmtsQuery, error) {
+	if req.Cmts == "" {
+		return cmrepo.CmtsQuery{}, status.Error(codes.InvalidArgument, "cmts is required")
+	}
+	return cmrepo.CmtsQuery{
+		Cmts:   req.Cmts,
+		State:  helpers.StateToDomain(req.State),
+		Docsis: helpers.DocsisVersionToDomain(req.Docsis),
+		Single: req.Single,
+	}, nil
+}
+
+// pollerQuery 校验 ByPoller 请求并转换为仓库查询，ByPoller 和 StreamByPoller 共用
+func pollerQuery(req *cablemodems.ByPollerRequest) (cmrepo.PollerQuery, error) {
+	poller := cmrepo.PollerType(req.Poller)
+	if !poller.IsValid() {
+		return cmrepo.PollerQuery{}, status.Errorf(codes.InvalidArgument, "invalid poller: %s", req.Poller)
+	}
+	if req.Cmts == "" {
+		return cmrepo.PollerQuery{}, status.Error(codes.InvalidArgument, "cmts is required")
+	}
+	return cmrepo.PollerQuery{
+		Poller: poller,
+		Cmts:   req.Cmts,
+		State:  helpers.StateToDomain(req.State),
+		Docsis: helpers.DocsisVersionToDomain(req.Docsis),
+	}, nil
+}
+
+// pagedFilter 转换 Paged 请求的 filter 并规范化其中的 mac
+func pagedFilter(req *cablemodems.PagedRequest) (*cmrepo.Filter, error) {
+	filter := helpers.FilterToDomain(req.Filter)
+	if err := filter.NormalizeMacs(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return filter, nil
+}
+
+// HistoricalRegState 查询 cable modem 按分钟或小时汇总的历史 reg state，非法的 mac 作为部分失败写入 error
+func (h *CableModemMethod) HistoricalRegState(ctx context.Context, req *cablemodems.HistoricalRegStateRequest) (*cablemodems.HistoricalRegStateResponse, error) {
+	if h.Repo == nil {
+		return nil, status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	if len(req.Mac) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "mac list is empty")
+	}
+	period := cmrepo.HistoricalPeriod(req.Period)
+	if period != cmrepo.Minutely && period != cmrepo.Hourly {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid period: %s", req.Period)
+	}
+
+	var failed partial
+	macs, err := validMacs(req.Mac, &failed)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := cmrepo.ResolveTimeRange(period, req.From, req.To, time.Now())
+	devices, err := h.Repo.HistoricalRegState(ctx, macs, period, rng)
+	if err != nil {
+		return nil, repoError(err)
+	}
+
+	return &cablemodems.HistoricalRegStateResponse{
+		Devices: helpers.TsRegStateDevicesToProto(devices),
+		Error:   failed.proto(),
+	}, nil
+}
+
+// HistoricalCm 查询 cable modem 的历史信道数据，非法的 mac 作为部分失败写入 error
+func (h *CableModemMethod) HistoricalCm(ctx context.Context, req *cablemodems.HistoricalCmRequest) (*cablemodems.HistoricalCmResponse, error) {
+	if h.Repo == nil {
+		return nil, status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	if len(req.Mac) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "mac list is empty")
+	}
+
+	var failed partial
+	macs, err := validMacs(req.Mac, &failed)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := cmrepo.ResolveTimeRange(cmrepo.Minutely, req.From, req.To, time.Now())
+	devices, err := h.Repo.HistoricalCm(ctx, macs, rng)
+	if err != nil {
+		return nil, repoError(err)
+	}
+
+	return &cablemodems.HistoricalCmResponse{
+		Devices: helpers.TsCmDevicesToProto(devices),
+		Error:   failed.proto(),
 	}, nil
 }
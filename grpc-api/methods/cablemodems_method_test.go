@@ -0,0 +1,265 @@
+package methods
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"api-project/grpc-api/gen/cablemodems"
+	"api-project/grpc-api/gen/common"
+	cmrepo "api-project/pkg/cablemodems"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestMethod serves the shared modem fixture from memory.
+func newTestMethod(t *testing.T) (*CableModemMethod, *cmrepo.Memory) {
+	t.Helper()
+	modems, err := cmrepo.LoadFixture("../../pkg/cablemodems/testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mem := cmrepo.NewMemory(modems)
+	return &CableModemMethod{Repo: mem}, mem
+}
+
+func TestCableModemMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	h, _ := newTestMethod(t)
+	for _, tt := range []struct {
+		name string
+		call func() ([]*cablemodems.CableModem, error)
+		code codes.Code
+		macs []string
+	}{
+		{"ByMac", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"ByMac notations", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"5C:22:DA:0E:9F:01", "a4b1.e900.0003"}})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"ByMac invalid", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"bad1", "nope"}})
+			return resp.GetModems(), err
+		}, codes.InvalidArgument, nil},
+		{"ByMac empty", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{})
+			return resp.GetModems(), err
+		}, codes.InvalidArgument, nil},
+		{"ByCmts", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByCmts(ctx, &cablemodems.ByCmtsRequest{Cmts: "den01"})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"ByCmts filters", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByCmts(ctx, &cablemodems.ByCmtsRequest{
+				Cmts: "acr01.den.example.net", State: cablemodems.State_OFFLINE, Docsis: cablemodems.DocsisVersion_DOCSIS3,
+			})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:03"}},
+		{"ByCmts single", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByCmts(ctx, &cablemodems.ByCmtsRequest{Cmts: "acr01.den.example.net", Single: true})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:01"}},
+		{"ByCmts missing", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByCmts(ctx, &cablemodems.ByCmtsRequest{})
+			return resp.GetModems(), err
+		}, codes.InvalidArgument, nil},
+		{"ByPoller", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByPoller(ctx, &cablemodems.ByPollerRequest{Poller: "RX_MER", Cmts: "acr01.den.example.net"})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}},
+		{"ByPoller unknown", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.ByPoller(ctx, &cablemodems.ByPollerRequest{Poller: "SNMP", Cmts: "den01"})
+			return resp.GetModems(), err
+		}, codes.InvalidArgument, nil},
+		{"Paged", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.Paged(ctx, &cablemodems.PagedRequest{First: 2})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"Paged filter", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.Paged(ctx, &cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{FiberNode: "FN-102"}})
+			return resp.GetModems(), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"Paged bad mac", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.Paged(ctx, &cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{MacAddress: []string{"a4b1e9"}}})
+			return resp.GetModems(), err
+		}, codes.InvalidArgument, nil},
+		{"Paged bad cursor", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.Paged(ctx, &cablemodems.PagedRequest{After: "not-a-cursor"})
+			return resp.GetModems(), err
+		}, codes.InvalidArgument, nil},
+		{"Paged bad first", func() ([]*cablemodems.CableModem, error) {
+			resp, err := h.Paged(ctx, &cablemodems.PagedRequest{First: -1})
+			return resp.GetModems(), err
+		}, codes.InvalidArgument, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			modems, err := tt.call()
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("got %s (%v), want %s", got, err, tt.code)
+			}
+			if tt.macs == nil {
+				return
+			}
+			macs := []string{}
+			for _, m := range modems {
+				macs = append(macs, m.Mac)
+			}
+			if !reflect.DeepEqual(macs, tt.macs) {
+				t.Errorf("got %v, want %v", macs, tt.macs)
+			}
+		})
+	}
+}
+
+func TestPagedNextCursor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	h, _ := newTestMethod(t)
+	var macs []string
+	req := &cablemodems.PagedRequest{First: 3}
+	for i := 0; i < 5; i++ {
+		resp, err := h.Paged(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range resp.Modems {
+			macs = append(macs, m.Mac)
+		}
+		if !resp.HasNextPage {
+			break
+		}
+		req.After = resp.NextCursor
+	}
+	if len(macs) != 7 {
+		t.Errorf("paged through %v, want all 7 modems", macs)
+	}
+}
+
+func TestHistorical(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	h, mem := newTestMethod(t)
+	mac, regState, resets := "5c:22:da:0e:9f:01", int32(6), int32(3)
+	at := time.Unix(1790000000, 0)
+	if err := mem.RecordRegState(ctx, at, []*cmrepo.TsRegStateDevice{{Mac: &mac, RegState: &regState}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mem.RecordCm(ctx, at, []*cmrepo.TsCmDevice{{Mac: &mac, Resets: &resets}}); err != nil {
+		t.Fatal(err)
+	}
+	from, to := at.Add(-time.Hour).Unix(), at.Add(time.Hour).Unix()
+
+	rs, err := h.HistoricalRegState(ctx, &cablemodems.HistoricalRegStateRequest{Mac: []string{mac}, Period: "Hourly", From: from, To: to})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs.Devices) != 1 || rs.Devices[0].GetMac() != mac {
+		t.Errorf("HistoricalRegState: got %v", rs.Devices)
+	}
+	cm, err := h.HistoricalCm(ctx, &cablemodems.HistoricalCmRequest{Mac: []string{mac}, From: from, To: to})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cm.Devices) != 1 || cm.Devices[0].GetResets() != resets {
+		t.Errorf("HistoricalCm: got %v", cm.Devices)
+	}
+
+	for _, tt := range []struct {
+		name string
+		err  error
+	}{
+		{"bad period", func() error {
+			_, err := h.HistoricalRegState(ctx, &cablemodems.HistoricalRegStateRequest{Mac: []string{mac}, Period: "Daily"})
+			return err
+		}()},
+		{"reg state without mac", func() error {
+			_, err := h.HistoricalRegState(ctx, &cablemodems.HistoricalRegStateRequest{Period: "Hourly"})
+			return err
+		}()},
+		{"cm without mac", func() error {
+			_, err := h.HistoricalCm(ctx, &cablemodems.HistoricalCmRequest{})
+			return err
+		}()},
+		{"empty range", func() error {
+			_, err := h.HistoricalCm(ctx, &cablemodems.HistoricalCmRequest{Mac: []string{mac}, From: to, To: from})
+			return err
+		}()},
+	} {
+		if got := status.Code(tt.err); got != codes.InvalidArgument {
+			t.Errorf("%s: got %s, want InvalidArgument", tt.name, got)
+		}
+	}
+}
+
+func TestPartialFailures(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	h, mem := newTestMethod(t)
+	// a value written by something that bypassed the API's enum checks.
+	sleeping := cmrepo.State("Sleeping")
+	if _, err := mem.Upsert(ctx, []*cmrepo.CableModem{{Mac: "a4:b1:e9:00:00:ff", State: &sleeping}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name    string
+		call    func() ([]*cablemodems.CableModem, *common.Error, error)
+		macs    []string
+		code    codes.Code
+		message string
+	}{
+		{"ByMac", func() ([]*cablemodems.CableModem, *common.Error, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}})
+			return resp.GetModems(), resp.GetError(), err
+		}, []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}, codes.OK, ""},
+		{"ByMac invalid", func() ([]*cablemodems.CableModem, *common.Error, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"5c:22:da:0e:9f:01", "nope", "ff:ff:ff:ff:ff:ff"}})
+			return resp.GetModems(), resp.GetError(), err
+		}, []string{"5c:22:da:0e:9f:01"}, codes.InvalidArgument, `invalid mac address: "nope"; cable modem not found: ff:ff:ff:ff:ff:ff`},
+		{"ByMac not found", func() ([]*cablemodems.CableModem, *common.Error, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"FF:FF:FF:FF:FF:FF", "5c:22:da:0e:9f:01", "ffff.ffff.ffff"}})
+			return resp.GetModems(), resp.GetError(), err
+		}, []string{"5c:22:da:0e:9f:01"}, codes.NotFound, "cable modem not found: ff:ff:ff:ff:ff:ff"},
+		{"ByMac unknown enum", func() ([]*cablemodems.CableModem, *common.Error, error) {
+			resp, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{"a4:b1:e9:00:00:ff"}})
+			return resp.GetModems(), resp.GetError(), err
+		}, []string{"a4:b1:e9:00:00:ff"}, codes.Internal, "cable modem a4:b1:e9:00:00:ff: invalid state: Sleeping"},
+		{"Paged unknown enum", func() ([]*cablemodems.CableModem, *common.Error, error) {
+			resp, err := h.Paged(ctx, &cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{MacAddress: []string{"a4:b1:e9:00:00:ff"}}})
+			return resp.GetModems(), resp.GetError(), err
+		}, []string{"a4:b1:e9:00:00:ff"}, codes.Internal, "cable modem a4:b1:e9:00:00:ff: invalid state: Sleeping"},
+		{"HistoricalCm invalid", func() ([]*cablemodems.CableModem, *common.Error, error) {
+			resp, err := h.HistoricalCm(ctx, &cablemodems.HistoricalCmRequest{Mac: []string{"5c:22:da:0e:9f:01", "nope"}})
+			return nil, resp.GetError(), err
+		}, nil, codes.InvalidArgument, `invalid mac address: "nope"`},
+		{"HistoricalRegState invalid", func() ([]*cablemodems.CableModem, *common.Error, error) {
+			resp, err := h.HistoricalRegState(ctx, &cablemodems.HistoricalRegStateRequest{Mac: []string{"nope", "5c:22:da:0e:9f:01"}, Period: "Minutely"})
+			return nil, resp.GetError(), err
+		}, nil, codes.InvalidArgument, `invalid mac address: "nope"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			modems, failed, err := tt.call()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := codes.Code(failed.GetCode()); got != tt.code || failed.GetMessage() != tt.message {
+				t.Errorf("got error %s %q, want %s %q", got, failed.GetMessage(), tt.code, tt.message)
+			}
+			if tt.macs == nil {
+				return
+			}
+			macs := []string{}
+			for _, m := range modems {
+				macs = append(macs, m.Mac)
+			}
+			if !reflect.DeepEqual(macs, tt.macs) {
+				t.Errorf("got %v, want %v", macs, tt.macs)
+			}
+		})
+	}
+}
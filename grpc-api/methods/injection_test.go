@@ -0,0 +1,101 @@
+package methods
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"api-project/grpc-api/gen/cablemodems"
+	cmrepo "api-project/pkg/cablemodems"
+	"api-project/pkg/sqltest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHostileRequestFieldsAreBound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name string
+		call func(h *CableModemMethod, v string) error
+		// mac fields are rejected before any query runs.
+		mac bool
+	}{
+		{"ByMac", func(h *CableModemMethod, v string) error {
+			_, err := h.ByMac(ctx, &cablemodems.ByMacRequest{MacAddress: []string{v}})
+			return err
+		}, true},
+		{"ByCmts", func(h *CableModemMethod, v string) error {
+			_, err := h.ByCmts(ctx, &cablemodems.ByCmtsRequest{Cmts: v})
+			return err
+		}, false},
+		{"ByCmts single", func(h *CableModemMethod, v string) error {
+			_, err := h.ByCmts(ctx, &cablemodems.ByCmtsRequest{Cmts: v, Single: true})
+			return err
+		}, false},
+		{"ByPoller", func(h *CableModemMethod, v string) error {
+			_, err := h.ByPoller(ctx, &cablemodems.ByPollerRequest{Poller: string(cmrepo.RxMer), Cmts: v})
+			return err
+		}, false},
+		{"Paged fqdn", func(h *CableModemMethod, v string) error {
+			_, err := h.Paged(ctx, &cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{Fqdn: v}})
+			return err
+		}, false},
+		{"Paged ppod", func(h *CableModemMethod, v string) error {
+			_, err := h.Paged(ctx, &cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{PpodName: v}})
+			return err
+		}, false},
+		{"Paged mac", func(h *CableModemMethod, v string) error {
+			_, err := h.Paged(ctx, &cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{MacAddress: []string{v, v}}})
+			return err
+		}, true},
+		{"Paged mac domain", func(h *CableModemMethod, v string) error {
+			_, err := h.Paged(ctx, &cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{MacDomain: v, DsInterface: v, FiberNode: v}})
+			return err
+		}, false},
+		{"HistoricalRegState", func(h *CableModemMethod, v string) error {
+			_, err := h.HistoricalRegState(ctx, &cablemodems.HistoricalRegStateRequest{Mac: []string{v}, Period: string(cmrepo.Hourly)})
+			return err
+		}, true},
+		{"HistoricalCm", func(h *CableModemMethod, v string) error {
+			_, err := h.HistoricalCm(ctx, &cablemodems.HistoricalCmRequest{Mac: []string{v}})
+			return err
+		}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range sqltest.Hostile {
+				rec := sqltest.New(t)
+				err := tt.call(&CableModemMethod{Repo: cmrepo.NewPostgres(rec)}, v)
+				if tt.mac {
+					if status.Code(err) != codes.InvalidArgument || len(rec.Statements()) != 0 {
+						t.Errorf("%q: got %v, want InvalidArgument without touching the database", v, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("%q: %v", v, err)
+					continue
+				}
+				rec.AssertBound(t, v)
+			}
+		})
+	}
+}
+
+func TestByMacBindsOneArray(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	h := &CableModemMethod{Repo: cmrepo.NewPostgres(rec)}
+	macs := make([]string, 70_000)
+	for i := range macs {
+		macs[i] = fmt.Sprintf("5c:22:da:%02x:%02x:%02x", i>>16, i>>8&0xff, i&0xff)
+	}
+	if _, err := h.ByMac(context.Background(), &cablemodems.ByMacRequest{MacAddress: macs}); err != nil {
+		t.Fatal(err)
+	}
+	if statements := rec.Statements(); len(statements) != 1 || !strings.Contains(statements[0].Query, "mac = ANY($1)") {
+		t.Errorf("got %d statements, want one binding every mac as an array", len(statements))
+	}
+}
@@ -0,0 +1,79 @@
+package methods
+
+import (
+	"errors"
+	"strings"
+
+	"api-project/grpc-api/gen/common"
+	"api-project/pkg/mac"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// partial 收集不影响其余结果的部分失败，写入响应的 error 字段。code 取第一个失败的 code，message 依次列出所有失败
+type partial struct {
+	code     codes.Code
+	messages []string
+}
+
+func (p *partial) add(code codes.Code, message string) {
+	if len(p.messages) == 0 {
+		p.code = code
+	}
+	p.messages = append(p.messages, message)
+}
+
+// addErr 把 err 作为 code 失败加入，err 为 nil 时不做任何事
+func (p *partial) addErr(code codes.Code, err error) {
+	if err != nil {
+		p.add(code, strings.ReplaceAll(err.Error(), "\n", "; "))
+	}
+}
+
+// proto 返回响应的 error 字段，没有部分失败时为 nil
+func (p *partial) proto() *common.Error {
+	if len(p.messages) == 0 {
+		return nil
+	}
+	return &common.Error{Code: int32(p.code), Message: strings.Join(p.messages, "; ")}
+}
+
+// validMacs 规范化 mac，只查询合法的那些，非法的 mac 作为 InvalidArgument 部分失败报告。
+// 全部非法时没有可返回的结果，返回 InvalidArgument
+func validMacs(values []string, p *partial) ([]string, error) {
+	macs, err := mac.Normalize(values)
+	var invalid *mac.InvalidError
+	if !errors.As(err, &invalid) {
+		return macs, err
+	}
+	macs = make([]string, 0, len(values)-len(invalid.Invalid))
+	for _, v := range values {
+		if a, err := mac.Parse(v); err == nil {
+			macs = append(macs, a.String())
+		}
+	}
+	if len(macs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	p.add(codes.InvalidArgument, err.Error())
+	return macs, nil
+}
+
+// missingMacs 把请求了但没有查到的 mac 作为 NotFound 部分失败报告
+func missingMacs(macs []string, found []string, p *partial) {
+	seen := make(map[string]bool, len(found))
+	for _, m := range found {
+		seen[m] = true
+	}
+	var missing []string
+	for _, m := range macs {
+		if !seen[m] {
+			missing = append(missing, m)
+			seen[m] = true
+		}
+	}
+	if len(missing) > 0 {
+		p.add(codes.NotFound, "cable modem not found: "+strings.Join(missing, ", "))
+	}
+}
@@ -0,0 +1,96 @@
+package methods
+
+import (
+	"errors"
+
+	"api-project/grpc-api/gen/cablemodems"
+	"api-project/grpc-api/helpers"
+	cmrepo "api-project/pkg/cablemodems"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errEnough 表示 StreamPaged 已经发送了 first 条
+var errEnough = errors.New("enough modems streamed")
+
+// StreamByCmts 是 ByCmts 的流式版本，每读到一条 modem 就发送一条
+func (h *CableModemMethod) StreamByCmts(req *cablemodems.ByCmtsRequest, stream grpc.ServerStreamingServer[cablemodems.CableModem]) error {
+	if h.Repo == nil {
+		return status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	q, err := cmtsQuery(req)
+	if err != nil {
+		return err
+	}
+
+	err = h.Repo.EachByCmts(stream.Context(), q, func(m *cmrepo.CableModem) error {
+		return stream.Send(protoModem(m))
+	})
+	return streamError(err)
+}
+
+// StreamByPoller 是 ByPoller 的流式版本，每读到一条 modem 就发送一条
+func (h *CableModemMethod) StreamByPoller(req *cablemodems.ByPollerRequest, stream grpc.ServerStreamingServer[cablemodems.CableModem]) error {
+	if h.Repo == nil {
+		return status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	q, err := pollerQuery(req)
+	if err != nil {
+		return err
+	}
+
+	err = h.Repo.EachByPoller(stream.Context(), q, func(m *cmrepo.CableModem) error {
+		return stream.Send(protoModem(m))
+	})
+	return streamError(err)
+}
+
+// StreamPaged 不分页地发送 after 之后所有匹配 filter 的 modem，first 不为 0 时最多发送 first 条。
+// 每条都带着自己的 cursor，断开后可以从收到的最后一条继续
+func (h *CableModemMethod) StreamPaged(req *cablemodems.PagedRequest, stream grpc.ServerStreamingServer[cablemodems.PagedItem]) error {
+	if h.Repo == nil {
+		return status.Error(codes.Internal, "cable modem repository is nil")
+	}
+	if req.First < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid first: %d", req.First)
+	}
+	filter, err := pagedFilter(req)
+	if err != nil {
+		return err
+	}
+
+	var sent int32
+	err = h.Repo.EachFiltered(stream.Context(), filter, req.After, func(m *cmrepo.CableModem) error {
+		if req.First > 0 && sent == req.First {
+			return errEnough
+		}
+		sent++
+		return stream.Send(&cablemodems.PagedItem{
+			Modem:  protoModem(m),
+			Cursor: cmrepo.Cursor(m),
+		})
+	})
+	if errors.Is(err, errEnough) {
+		return nil
+	}
+	return streamError(err)
+}
+
+// protoModem 转换流中的一条 modem。流没有 error 字段，无法转换的枚举值只以 UNKNOWN 出现
+func protoModem(m *cmrepo.CableModem) *cablemodems.CableModem {
+	out, _ := helpers.CableModemToProto(m)
+	return out
+}
+
+// streamError 映射流式查询的错误，Send 返回的错误已经是 gRPC status，原样返回
+func streamError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return repoError(err)
+}
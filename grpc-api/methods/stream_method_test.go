@@ -0,0 +1,126 @@
+package methods
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"api-project/grpc-api/gen/cablemodems"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStream records what a server-streaming method sends. cancelAfter cancels the stream context once that
+// many messages were sent, as a client going away would.
+type fakeStream[T any] struct {
+	grpc.ServerStream
+	ctx         context.Context
+	cancel      context.CancelFunc
+	cancelAfter int
+	sent        []*T
+}
+
+func newFakeStream[T any](cancelAfter int) *fakeStream[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fakeStream[T]{ctx: ctx, cancel: cancel, cancelAfter: cancelAfter}
+}
+
+func (s *fakeStream[T]) Context() context.Context { return s.ctx }
+
+func (s *fakeStream[T]) Send(m *T) error {
+	s.sent = append(s.sent, m)
+	if len(s.sent) == s.cancelAfter {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestStreams(t *testing.T) {
+	t.Parallel()
+	h, _ := newTestMethod(t)
+	for _, tt := range []struct {
+		name        string
+		cancelAfter int
+		call        func(cancelAfter int) ([]string, error)
+		code        codes.Code
+		macs        []string
+	}{
+		{"StreamByCmts", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.CableModem](n)
+			err := h.StreamByCmts(&cablemodems.ByCmtsRequest{Cmts: "acr01.den.example.net"}, s)
+			return sentMacs(s.sent, (*cablemodems.CableModem).GetMac), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"StreamByCmts cancelled", 2, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.CableModem](n)
+			err := h.StreamByCmts(&cablemodems.ByCmtsRequest{Cmts: "acr01.den.example.net"}, s)
+			return sentMacs(s.sent, (*cablemodems.CableModem).GetMac), err
+		}, codes.Canceled, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}},
+		{"StreamByCmts missing", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.CableModem](n)
+			return nil, h.StreamByCmts(&cablemodems.ByCmtsRequest{}, s)
+		}, codes.InvalidArgument, nil},
+		{"StreamByPoller", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.CableModem](n)
+			err := h.StreamByPoller(&cablemodems.ByPollerRequest{Poller: "MTA_INVENTORY", Cmts: "den01"}, s)
+			return sentMacs(s.sent, (*cablemodems.CableModem).GetMac), err
+		}, codes.OK, []string{"a4:b1:e9:00:00:01"}},
+		{"StreamByPoller unknown", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.CableModem](n)
+			return nil, h.StreamByPoller(&cablemodems.ByPollerRequest{Poller: "SNMP", Cmts: "den01"}, s)
+		}, codes.InvalidArgument, nil},
+		{"StreamPaged", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.PagedItem](n)
+			err := h.StreamPaged(&cablemodems.PagedRequest{Filter: &cablemodems.CableModemsFilter{FiberNode: "FN-102"}}, s)
+			return sentMacs(s.sent, func(i *cablemodems.PagedItem) string { return i.GetModem().GetMac() }), err
+		}, codes.OK, []string{"5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"StreamPaged first", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.PagedItem](n)
+			err := h.StreamPaged(&cablemodems.PagedRequest{First: 3}, s)
+			return sentMacs(s.sent, func(i *cablemodems.PagedItem) string { return i.GetModem().GetMac() }), err
+		}, codes.OK, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02", "5c:22:da:0e:9f:01"}},
+		{"StreamPaged bad cursor", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.PagedItem](n)
+			return nil, h.StreamPaged(&cablemodems.PagedRequest{After: "not-a-cursor"}, s)
+		}, codes.InvalidArgument, nil},
+		{"StreamPaged bad first", 0, func(n int) ([]string, error) {
+			s := newFakeStream[cablemodems.PagedItem](n)
+			return nil, h.StreamPaged(&cablemodems.PagedRequest{First: -1}, s)
+		}, codes.InvalidArgument, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			macs, err := tt.call(tt.cancelAfter)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("got %s (%v), want %s", got, err, tt.code)
+			}
+			if tt.macs != nil && !reflect.DeepEqual(macs, tt.macs) {
+				t.Errorf("got %v, want %v", macs, tt.macs)
+			}
+		})
+	}
+}
+
+func TestStreamPagedResumes(t *testing.T) {
+	t.Parallel()
+	h, _ := newTestMethod(t)
+	first := newFakeStream[cablemodems.PagedItem](0)
+	if err := h.StreamPaged(&cablemodems.PagedRequest{First: 4}, first); err != nil {
+		t.Fatal(err)
+	}
+	rest := newFakeStream[cablemodems.PagedItem](0)
+	if err := h.StreamPaged(&cablemodems.PagedRequest{After: first.sent[len(first.sent)-1].Cursor}, rest); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(first.sent) + len(rest.sent); n != 7 {
+		t.Errorf("streamed %d modems, want all 7", n)
+	}
+}
+
+func sentMacs[T any](sent []*T, mac func(*T) string) []string {
+	macs := []string{}
+	for _, m := range sent {
+		macs = append(macs, mac(m))
+	}
+	return macs
+}
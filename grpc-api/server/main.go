@@ -1,36 +1,98 @@
 package main
 
 import (
-	"log"
+	"context"
+	"expvar"
+	"flag"
 	"net"
+	"net/http"
+	"time"
 
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	"api-project/grpc-api/gen/cablemodems"
+	"api-project/grpc-api/healthcheck"
+	"api-project/grpc-api/interceptors"
 	"api-project/grpc-api/methods"
+	"api-project/grpc-api/tlsconfig"
+	cmrepo "api-project/pkg/cablemodems"
 	"api-project/pkg/dbservice"
+	"api-project/pkg/envvar"
 )
 
+// maxRecvMsgSize 让 ByMac 一次可以带上百万个 mac，gRPC 默认的 4MB 只够大约三十万个
+const maxRecvMsgSize = 64 << 20
+
 func main() {
+	fixture := flag.String("fixture", "", "serve the modems of this .json or .csv fixture from memory instead of Postgres")
+	debugAddr := flag.String("debug-addr", ":50052", "serve the call metrics on /debug/vars at this address, empty to disable")
+	flag.Parse()
+
 	// 监听 TCP 端口
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		log.Fatal().Err(err).Msg("failed to listen")
 	}
 
-	// 创建 gRPC server 实例
-	grpcServer := grpc.NewServer()
+	// 设置了 GRPC_TLS_CERT/GRPC_TLS_KEY 时使用 TLS，再设置 GRPC_TLS_CA 时要求客户端证书（mTLS）；
+	// 证书文件变化后自动重新加载，不需要重启
+	creds, reloader, err := tlsconfig.FromEnv().ServerCredentials()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load TLS certificates")
+	}
+	go reloader.Watch(context.Background())
 
-	dbService := dbservice.DbService
+	// 创建 gRPC server 实例，每个调用都经过请求 ID、日志、指标和 panic 恢复拦截器
+	opts := append(interceptors.ServerOptions(), grpc.Creds(creds), grpc.MaxRecvMsgSize(maxRecvMsgSize))
+	grpcServer := grpc.NewServer(opts...)
+
+	var repo cmrepo.Store
+	// fixture 模式没有数据库，总是 SERVING
+	check := func(context.Context) error { return nil }
+	if *fixture != "" {
+		// 本地开发：不连数据库，直接从 fixture 加载到内存
+		modems, err := cmrepo.LoadFixture(*fixture)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load fixture")
+		}
+		repo = cmrepo.NewMemory(modems)
+	} else {
+		// 数据库不可达时以 not ready 状态启动，后台持续重连
+		dbService := dbservice.New(context.Background(), dbservice.OptionsFromEnv())
+		repo = cmrepo.NewPostgres(dbService)
+		check = dbService.Ping
+	}
 
 	// 注册 CableModemService
 	cablemodems.RegisterCableModemServiceServer(grpcServer, &methods.CableModemMethod{
-		Db: dbService.DbReader,
+		Repo: repo,
 	})
 
-	log.Println("gRPC server listening on :50051")
+	// grpc.health.v1.Health 跟随数据库是否可达，供编排系统做健康检查；
+	// 反射让 grpcurl、Kreya 等客户端不需要 .proto 文件也能发现服务
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
+	go healthcheck.Watch(context.Background(), healthServer, check, envvar.GetDuration("GRPC_HEALTH_INTERVAL", 5*time.Second),
+		"", cablemodems.CableModemService_ServiceDesc.ServiceName)
+
+	if *debugAddr != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/debug/vars", expvar.Handler())
+			if err := http.ListenAndServe(*debugAddr, mux); err != nil {
+				log.Error().Err(err).Str("addr", *debugAddr).Msg("debug server stopped")
+			}
+		}()
+	}
+
+	log.Info().Str("addr", ":50051").Str("security", creds.Info().SecurityProtocol).Msg("gRPC server listening")
 	// 启动 server
 	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+		log.Fatal().Err(err).Msg("failed to serve")
 	}
 }
@@ -0,0 +1,23 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/x509/pkix"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientSubject returns the subject of the certificate the client of a call presented and the server verified,
+// for handlers that authorize by it. ok is false on a plaintext or server-only TLS connection.
+func ClientSubject(ctx context.Context) (subject pkix.Name, ok bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return pkix.Name{}, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return pkix.Name{}, false
+	}
+	return info.State.VerifiedChains[0][0].Subject, true
+}
@@ -0,0 +1,100 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Reloader holds the certificate and CA pool read from a Config's files and rereads them when the files change.
+// New handshakes use the new files; established connections keep the certificates they were made with.
+type Reloader struct {
+	certFile, keyFile, caFile string
+	interval                  time.Duration
+
+	cert atomic.Pointer[tls.Certificate]
+	pool atomic.Pointer[x509.CertPool]
+	// stamp identifies the versions of the files last read, only touched by load.
+	stamp string
+}
+
+func newReloader(c Config) (*Reloader, error) {
+	r := &Reloader{certFile: c.CertFile, keyFile: c.KeyFile, caFile: c.CAFile, interval: c.ReloadInterval}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Watch reloads the files every interval until ctx is done. A file that fails to load, say halfway through
+// being rewritten, is logged and retried next time while the previous certificates stay in use. Watch returns
+// at once on a nil Reloader, so a plaintext setup can call it too.
+func (r *Reloader) Watch(ctx context.Context) {
+	if r == nil || r.interval <= 0 {
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.interval):
+		}
+		if changed, err := r.reload(); err != nil {
+			log.Error().Err(err).Str("cert", r.certFile).Str("ca", r.caFile).Msg("tls: failed to reload certificates")
+		} else if changed {
+			log.Info().Str("cert", r.certFile).Str("ca", r.caFile).Msg("tls: certificates reloaded")
+		}
+	}
+}
+
+// reload reads the files again if any of them changed since the last read. Rotation tools often replace a
+// file or the symlink to it, so a change is any difference in size or modification time.
+func (r *Reloader) reload() (bool, error) {
+	stamp, err := r.fileStamp()
+	if err != nil {
+		return false, err
+	}
+	if stamp == r.stamp {
+		return false, nil
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		c, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return false, fmt.Errorf("tls: %w", err)
+		}
+		cert = &c
+	}
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		if pool, err = readPool(r.caFile); err != nil {
+			return false, err
+		}
+	}
+	r.cert.Store(cert)
+	r.pool.Store(pool)
+	r.stamp = stamp
+	return true, nil
+}
+
+func (r *Reloader) fileStamp() (string, error) {
+	var stamp string
+	for _, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		fi, err := os.Stat(path)
+		if err != nil {
+			return "", fmt.Errorf("tls: %w", err)
+		}
+		stamp += fmt.Sprintf("%s:%d:%d;", path, fi.Size(), fi.ModTime().UnixNano())
+	}
+	return stamp, nil
+}
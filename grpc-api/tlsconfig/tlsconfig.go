@@ -0,0 +1,130 @@
+// Package tlsconfig builds the transport credentials of the gRPC server and client from PEM files named in the
+// environment: plaintext when none is set, TLS with a certificate, and mutual TLS with a client CA. Certificates
+// are reloaded when their files change, so a rotated certificate is served without a restart.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"api-project/pkg/envvar"
+)
+
+// Config names the PEM files of one side of a gRPC connection.
+type Config struct {
+	// CertFile and KeyFile are the certificate chain and private key this side presents. A server needs them to
+	// serve TLS; a client presents them to a server that requires mutual TLS.
+	CertFile, KeyFile string
+	// CAFile holds CA certificates. A server requires every client to present a certificate signed by one of
+	// them; a client verifies the server against them instead of the system roots.
+	CAFile string
+	// ServerName overrides the name a client verifies the server certificate for, when it differs from the
+	// dialed host.
+	ServerName string
+	// Enabled makes a client use TLS with the system roots when no file is set.
+	Enabled bool
+	// ReloadInterval is how often Reloader.Watch looks for changed files.
+	ReloadInterval time.Duration
+}
+
+// FromEnv reads the Config of the current process.
+func FromEnv() Config {
+	return Config{
+		CertFile:       envvar.GetString("GRPC_TLS_CERT", ""),
+		KeyFile:        envvar.GetString("GRPC_TLS_KEY", ""),
+		CAFile:         envvar.GetString("GRPC_TLS_CA", ""),
+		ServerName:     envvar.GetString("GRPC_TLS_SERVER_NAME", ""),
+		Enabled:        envvar.GetBool("GRPC_TLS", false),
+		ReloadInterval: envvar.GetDuration("GRPC_TLS_RELOAD_INTERVAL", 30*time.Second),
+	}
+}
+
+// ServerCredentials returns the credentials of a server and the Reloader that keeps its certificate and client
+// CAs current, or plaintext credentials and a nil Reloader when no file is set. A client CA without a
+// certificate is an error: mutual TLS needs TLS.
+func (c Config) ServerCredentials() (credentials.TransportCredentials, *Reloader, error) {
+	if c.CertFile == "" && c.KeyFile == "" && c.CAFile == "" {
+		return insecure.NewCredentials(), nil, nil
+	}
+	if c.CertFile == "" || c.KeyFile == "" {
+		return nil, nil, errors.New("tls: a server needs both a certificate and a key")
+	}
+	r, err := newReloader(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(r.serverConfig()), r, nil
+}
+
+// ClientCredentials returns the credentials of a client and the Reloader that keeps its certificate current, or
+// plaintext credentials and a nil Reloader when TLS is neither enabled nor implied by a file. The CA is read
+// once: the pool a client verifies servers with cannot change after the credentials are built.
+func (c Config) ClientCredentials() (credentials.TransportCredentials, *Reloader, error) {
+	if !c.Enabled && c.CertFile == "" && c.KeyFile == "" && c.CAFile == "" {
+		return insecure.NewCredentials(), nil, nil
+	}
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return nil, nil, errors.New("tls: a client certificate needs both a certificate and a key")
+	}
+	r, err := newReloader(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+		RootCAs:    r.pool.Load(),
+	}
+	if c.CertFile != "" {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.cert.Load(), nil
+		}
+	}
+	return credentials.NewTLS(cfg), r, nil
+}
+
+// serverConfig serves the current certificate and, with a CA, requires a client certificate verified by the
+// current pool. The pool is looked up per handshake through GetConfigForClient, as tls.Config has no callback
+// for it.
+func (r *Reloader) serverConfig() *tls.Config {
+	getCertificate := func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return r.cert.Load(), nil
+	}
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: getCertificate,
+	}
+	if r.caFile != "" {
+		cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				MinVersion:     tls.VersionTLS12,
+				GetCertificate: getCertificate,
+				ClientAuth:     tls.RequireAndVerifyClientCert,
+				ClientCAs:      r.pool.Load(),
+				// the config returned here replaces the one grpc added its ALPN protocol to.
+				NextProtos: []string{"h2"},
+			}, nil
+		}
+	}
+	return cfg
+}
+
+// readPool reads the PEM certificates of path into a pool.
+func readPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("tls: no certificate in %s", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,317 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// testCA signs the certificates of a test, all generated on the fly.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+var serial atomic.Int64
+
+func newCA(t *testing.T, dir string) *testCA {
+	t.Helper()
+	ca := &testCA{dir: dir}
+	ca.cert, ca.key = ca.sign(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+	return ca
+}
+
+// sign signs tmpl with the CA, or with its own key while the CA is being made.
+func (ca *testCA) sign(t *testing.T, tmpl *x509.Certificate) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(serial.Add(1))
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	parent, signer := tmpl, key
+	if ca.cert != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// writeCA writes the CA certificate to name and returns its path.
+func (ca *testCA) writeCA(t *testing.T, name string) string {
+	t.Helper()
+	path := filepath.Join(ca.dir, name)
+	writePEM(t, path, "CERTIFICATE", ca.cert.Raw)
+	return path
+}
+
+// issue writes a certificate for cn, valid for localhost, and its key to name.crt and name.key.
+func (ca *testCA) issue(t *testing.T, name, cn string) (certFile, keyFile string) {
+	t.Helper()
+	cert, key := ca.sign(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: cn, Organization: []string{"cable"}},
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	})
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile = filepath.Join(ca.dir, name+".crt"), filepath.Join(ca.dir, name+".key")
+	writePEM(t, certFile, "CERTIFICATE", cert.Raw)
+	writePEM(t, keyFile, "EC PRIVATE KEY", der)
+	return certFile, keyFile
+}
+
+// writePEM writes one PEM block to path, moving its modification time forward so that a rewrite within the
+// file system's timestamp resolution is still seen as a change.
+func writePEM(t *testing.T, path, kind string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: kind, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Now().Add(time.Duration(serial.Add(1)) * time.Second)
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// whoami answers every call with an error naming the subject of the client certificate, so the tests need no
+// generated service.
+func whoami(_ any, stream grpc.ServerStream) error {
+	subject, ok := ClientSubject(stream.Context())
+	if !ok {
+		return status.Error(codes.Unauthenticated, "no client certificate")
+	}
+	return status.Error(codes.PermissionDenied, subject.String())
+}
+
+// serve starts a server with the credentials of c on a local port and returns its address.
+func serve(t *testing.T, c Config) (string, *Reloader) {
+	t.Helper()
+	creds, r, err := c.ServerCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(creds), grpc.UnknownServiceHandler(whoami))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String(), r
+}
+
+// call calls whoami over a new connection with the credentials of c, returning the server's peer info.
+func call(t *testing.T, addr string, c Config) (*peer.Peer, error) {
+	t.Helper()
+	creds, _, err := c.ClientCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var p peer.Peer
+	err = conn.Invoke(ctx, "/test.Whoami/Call", &emptypb.Empty{}, &emptypb.Empty{}, grpc.Peer(&p))
+	return &p, err
+}
+
+func TestCredentials(t *testing.T) {
+	t.Parallel()
+	ca := newCA(t, t.TempDir())
+	caFile := ca.writeCA(t, "ca.crt")
+	serverCert, serverKey := ca.issue(t, "server", "localhost")
+	clientCert, clientKey := ca.issue(t, "client", "poller-1")
+	other := newCA(t, t.TempDir())
+	otherCert, otherKey := other.issue(t, "client", "intruder")
+
+	tlsAddr, _ := serve(t, Config{CertFile: serverCert, KeyFile: serverKey})
+	mtlsAddr, _ := serve(t, Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile})
+	plainAddr, _ := serve(t, Config{})
+
+	tests := []struct {
+		name    string
+		addr    string
+		client  Config
+		code    codes.Code
+		message string
+	}{
+		{name: "plaintext", addr: plainAddr, code: codes.Unauthenticated},
+		{name: "TLS", addr: tlsAddr, client: Config{CAFile: caFile}, code: codes.Unauthenticated},
+		{name: "TLS server name", addr: tlsAddr, client: Config{CAFile: caFile, ServerName: "localhost"}, code: codes.Unauthenticated},
+		{name: "TLS wrong server name", addr: tlsAddr, client: Config{CAFile: caFile, ServerName: "cmts.example"}, code: codes.Unavailable},
+		{name: "TLS system roots", addr: tlsAddr, client: Config{Enabled: true}, code: codes.Unavailable},
+		{name: "TLS plaintext client", addr: tlsAddr, code: codes.Unavailable},
+		{name: "mTLS", addr: mtlsAddr, client: Config{CAFile: caFile, CertFile: clientCert, KeyFile: clientKey},
+			code: codes.PermissionDenied, message: "CN=poller-1,O=cable"},
+		{name: "mTLS without client certificate", addr: mtlsAddr, client: Config{CAFile: caFile}, code: codes.Unavailable},
+		{name: "mTLS untrusted client certificate", addr: mtlsAddr, client: Config{CAFile: caFile, CertFile: otherCert, KeyFile: otherKey},
+			code: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := call(t, tt.addr, tt.client)
+			s := status.Convert(err)
+			if s.Code() != tt.code || (tt.message != "" && s.Message() != tt.message) {
+				t.Errorf("got %v, want %v %s", err, tt.code, tt.message)
+			}
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ca := newCA(t, dir)
+	caFile := ca.writeCA(t, "ca.crt")
+	certFile, keyFile := ca.issue(t, "server", "localhost")
+
+	if _, _, err := (Config{CAFile: caFile}).ServerCredentials(); err == nil {
+		t.Error("server with a client CA but no certificate: no error")
+	}
+	if _, _, err := (Config{CertFile: certFile}).ClientCredentials(); err == nil {
+		t.Error("client with a certificate but no key: no error")
+	}
+	if _, _, err := (Config{CertFile: certFile, KeyFile: filepath.Join(dir, "missing.key")}).ServerCredentials(); err == nil {
+		t.Error("missing key: no error")
+	}
+	if _, _, err := (Config{CertFile: certFile, KeyFile: keyFile, CAFile: keyFile}).ServerCredentials(); err == nil {
+		t.Error("CA file without certificates: no error")
+	}
+}
+
+func TestReload(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ca := newCA(t, dir)
+	caFile := ca.writeCA(t, "ca.crt")
+	serverCert, serverKey := ca.issue(t, "server", "localhost")
+	clientCert, clientKey := ca.issue(t, "client", "poller-1")
+	client := Config{CAFile: caFile, CertFile: clientCert, KeyFile: clientKey}
+	addr, r := serve(t, Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile})
+
+	served := func() string {
+		t.Helper()
+		p, err := call(t, addr, client)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Fatal(err)
+		}
+		return p.AuthInfo.(credentials.TLSInfo).State.PeerCertificates[0].Subject.CommonName
+	}
+	if cn := served(); cn != "localhost" {
+		t.Fatalf("served %q", cn)
+	}
+	if changed, err := r.reload(); changed || err != nil {
+		t.Errorf("unchanged files: reloaded %v, %v", changed, err)
+	}
+
+	// a renewed server certificate is served to new connections.
+	ca.issue(t, "server", "cmts-api")
+	if changed, err := r.reload(); !changed || err != nil {
+		t.Fatalf("renewed certificate: reloaded %v, %v", changed, err)
+	}
+	if cn := served(); cn != "cmts-api" {
+		t.Errorf("after renewal served %q", cn)
+	}
+
+	// after a CA rotation, clients holding certificates of the old CA are refused.
+	rotated := newCA(t, dir)
+	rotated.writeCA(t, "ca.crt")
+	rotated.issue(t, "server", "localhost")
+	if changed, err := r.reload(); !changed || err != nil {
+		t.Fatalf("rotated CA: reloaded %v, %v", changed, err)
+	}
+	if _, err := call(t, addr, client); status.Code(err) != codes.Unavailable {
+		t.Errorf("client certificate of the old CA: got %v", err)
+	}
+	rotated.issue(t, "client", "poller-2")
+	if _, err := call(t, addr, client); status.Convert(err).Message() != "CN=poller-2,O=cable" {
+		t.Errorf("client certificate of the new CA: got %v", err)
+	}
+
+	// a half-written file keeps the previous certificates in use.
+	if err := os.WriteFile(serverKey, []byte("-----BEGIN"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if changed, err := r.reload(); changed || err == nil {
+		t.Errorf("broken key: reloaded %v, %v", changed, err)
+	}
+	if cn := served(); cn != "localhost" {
+		t.Errorf("after a failed reload served %q", cn)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+	// a plaintext setup has no Reloader to watch.
+	var r *Reloader
+	r.Watch(context.Background())
+
+	dir := t.TempDir()
+	ca := newCA(t, dir)
+	certFile, keyFile := ca.issue(t, "server", "localhost")
+	_, r, err := (Config{CertFile: certFile, KeyFile: keyFile, ReloadInterval: time.Millisecond}).ServerCredentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Watch(ctx)
+		close(done)
+	}()
+
+	ca.issue(t, "server", "cmts-api")
+	deadline := time.Now().Add(5 * time.Second)
+	for r.cert.Load().Leaf.Subject.CommonName != "cmts-api" {
+		if time.Now().After(deadline) {
+			t.Fatal("renewed certificate not reloaded")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+}
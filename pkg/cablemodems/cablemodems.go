@@ -0,0 +1,58 @@
+// Package cablemodems is the repository behind every cable-modem lookup. The REST, gRPC and GraphQL APIs all go
+// through a Repository so that they return identical results and a query fix lands in one place.
+package cablemodems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	// DefaultPageSize is the page size of Paged when a client does not ask for one.
+	DefaultPageSize = 100
+	// MaxPageSize is the largest page Paged returns.
+	MaxPageSize = 10_000
+)
+
+var (
+	ErrNotReady         = errors.New("database not ready")
+	ErrEmptyValues      = errors.New("values slice is empty")
+	ErrMissingCmts      = errors.New("cmts is required")
+	ErrUnknownPoller    = errors.New("unknown poller type")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrInvalidFirst     = fmt.Errorf("first must be between 1 and %d", MaxPageSize)
+	ErrInvalidPeriod    = errors.New("invalid historical period")
+	ErrInvalidTimeRange = errors.New("time range must end after it starts")
+	// ErrUnfilterableColumn is a programming error: a lookup named a column outside filterableColumns.
+	ErrUnfilterableColumn = errors.New("column is not filterable")
+	ErrEmptyPatch         = errors.New("patch sets no column")
+	// ErrDuplicateMac rejects an upsert that gives the same mac twice, which would leave it unclear which
+	// copy to keep.
+	ErrDuplicateMac = errors.New("duplicate mac")
+)
+
+// Repository looks up cable modems.
+type Repository interface {
+	// ByMac returns the modems with the given mac addresses.
+	ByMac(ctx context.Context, macAddresses []string) ([]*CableModem, error)
+	// ByCmts returns the modems behind a CMTS.
+	ByCmts(ctx context.Context, q CmtsQuery) ([]*CableModem, error)
+	// ByPoller returns the modems a poller should poll on a CMTS.
+	ByPoller(ctx context.Context, q PollerQuery) ([]*CableModem, error)
+	// Paged returns at most first modems matching filter, starting after the cursor of a previous page.
+	Paged(ctx context.Context, filter *Filter, first int, after string) (*Page, error)
+	// HistoricalRegState returns the reg state of the given modems in every period bucket of rng.
+	HistoricalRegState(ctx context.Context, macAddresses []string, period HistoricalPeriod, rng TimeRange) ([]*TsRegStateDevice, error)
+	// HistoricalCm returns every channel sample of the given modems taken in rng.
+	HistoricalCm(ctx context.Context, macAddresses []string, rng TimeRange) ([]*TsCmDevice, error)
+}
+
+// Store is a Repository that also streams large lookups, ingests historical samples and changes modems.
+// Postgres is the production Store and Memory the one tests and local runs use.
+type Store interface {
+	Repository
+	Streamer
+	Recorder
+	Writer
+}
@@ -0,0 +1,79 @@
+package cablemodems
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+)
+
+// cableModemFields are the indexes of the CableModem fields backed by a column, and cableModemColumns the
+// matching column names, both in field order and both read from the db struct tags. Every query selects
+// exactly these columns by name, so adding or reordering columns in the table cannot shift a scan.
+var cableModemFields, cableModemColumns = columnsOf(reflect.TypeOf(CableModem{}))
+
+// selectCableModems selects every mapped column; append the WHERE clause.
+var selectCableModems = "SELECT " + strings.Join(cableModemColumns, ", ") + " FROM cablemodems"
+
+// filterableColumns are the only columns a lookup may name in its WHERE clause. Column names cannot be bound
+// like values, so any column chosen at runtime must come from this list.
+var filterableColumns = map[string]bool{
+	"mac":            true,
+	"cpe_mac":        true,
+	"fqdn":           true,
+	"ppod":           true,
+	"mac_domain":     true,
+	"fiber_node":     true,
+	"docsis_version": true,
+	"state":          true,
+}
+
+func columnsOf(t reflect.Type) (fields []int, columns []string) {
+	for i := 0; i < t.NumField(); i++ {
+		if col, ok := t.Field(i).Tag.Lookup("db"); ok && col != "-" {
+			fields = append(fields, i)
+			columns = append(columns, col)
+		}
+	}
+	return fields, columns
+}
+
+// scanTargets returns a pointer to every mapped field of m, in cableModemColumns order.
+func (m *CableModem) scanTargets() []interface{} {
+	v := reflect.ValueOf(m).Elem()
+	targets := make([]interface{}, len(cableModemFields))
+	for i, f := range cableModemFields {
+		targets[i] = v.Field(f).Addr().Interface()
+	}
+	return targets
+}
+
+// columnValues returns the value of every mapped field of m, in cableModemColumns order, as database/sql
+// binds it: nil for a nil pointer and the underlying string for the enum types.
+func (m *CableModem) columnValues() []interface{} {
+	v := reflect.ValueOf(m).Elem()
+	values := make([]interface{}, len(cableModemFields))
+	for i, f := range cableModemFields {
+		field := v.Field(f)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+		if field.Kind() == reflect.String {
+			values[i] = field.String()
+		} else {
+			values[i] = field.Interface()
+		}
+	}
+	return values
+}
+
+// scanCableModem scans the current row of a selectCableModems query.
+func scanCableModem(rows *sql.Rows) (*CableModem, error) {
+	cablemodem := &CableModem{}
+	if err := rows.Scan(cablemodem.scanTargets()...); err != nil {
+		return nil, err
+	}
+	return cablemodem, nil
+}
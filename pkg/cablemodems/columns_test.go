@@ -0,0 +1,33 @@
+package cablemodems
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCableModemColumns checks the struct side of the mapping; pkg/migrations TestInitialCableModems checks it
+// against the cablemodems table the migrations create.
+func TestCableModemColumns(t *testing.T) {
+	t.Parallel()
+	if got, want := len(cableModemColumns), reflect.TypeOf(CableModem{}).NumField(); got != want {
+		t.Fatalf("%d of %d CableModem fields have a db tag", got, want)
+	}
+	seen := map[string]bool{}
+	for _, col := range cableModemColumns {
+		if seen[col] {
+			t.Errorf("column %s is mapped twice", col)
+		}
+		seen[col] = true
+	}
+
+	// every target must point into the struct, in column order.
+	m := &CableModem{}
+	targets := m.scanTargets()
+	*(targets[0].(*string)) = "5c22da0e9fab"
+	if m.Mac != "5c22da0e9fab" || cableModemColumns[0] != "mac" {
+		t.Error("first scan target is not mac")
+	}
+	if got := cableModemColumns[len(cableModemColumns)-1]; got != "device_type" {
+		t.Errorf("last column: got %s, want device_type", got)
+	}
+}
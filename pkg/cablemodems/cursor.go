@@ -0,0 +1,62 @@
+package cablemodems
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// cursor is the keyset position of the last modem of a page. Paged orders by (fqdn, mac), so rows inserted while a
+// client is paging never shift the rows it has not seen yet, unlike LIMIT/OFFSET.
+type cursor struct {
+	Fqdn string `json:"f"`
+	Mac  string `json:"m"`
+}
+
+// cursorOf returns the cursor positioned on m. A NULL fqdn sorts as the empty string.
+func cursorOf(m *CableModem) cursor {
+	c := cursor{Mac: m.Mac}
+	if m.Fqdn != nil {
+		c.Fqdn = *m.Fqdn
+	}
+	return c
+}
+
+// encode returns the opaque form handed to clients.
+func (c cursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (c cursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if c.Mac == "" {
+		return c, fmt.Errorf("%w: missing mac", ErrInvalidCursor)
+	}
+	return c, nil
+}
+
+// newPage trims the one-past-the-end row fetched by Paged and positions the end cursor.
+// An empty page keeps the caller's cursor so that it can poll for new rows.
+func newPage(modems []*CableModem, first int, after string) *Page {
+	page := &Page{Modems: modems, EndCursor: after}
+	if len(modems) > first {
+		page.Modems = modems[:first]
+		page.HasNextPage = true
+	}
+	if n := len(page.Modems); n > 0 {
+		page.EndCursor = cursorOf(page.Modems[n-1]).encode()
+	}
+	return page
+}
+
+// before reports whether c sorts before d in the (fqdn, mac) order of Paged.
+func (c cursor) before(d cursor) bool {
+	return c.Fqdn < d.Fqdn || (c.Fqdn == d.Fqdn && c.Mac < d.Mac)
+}
@@ -0,0 +1,53 @@
+package cablemodems
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	t.Parallel()
+	fqdn := "acr01.den.example.net"
+	for _, m := range []*CableModem{
+		{Mac: "5c:22:da:0e:9f:ab", Fqdn: &fqdn},
+		{Mac: "5c:22:da:0e:9f:ac"},
+	} {
+		want := cursorOf(m)
+		got, err := decodeCursor(want.encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("got %+v, want %+v", got, want)
+		}
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{"not base64!", "bm90IGpzb24", cursor{Fqdn: "x"}.encode()} {
+		if _, err := decodeCursor(s); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%q: got %v, want ErrInvalidCursor", s, err)
+		}
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	t.Parallel()
+	modems := []*CableModem{{Mac: "a"}, {Mac: "b"}, {Mac: "c"}}
+
+	page := newPage(modems, 2, "")
+	if len(page.Modems) != 2 || !page.HasNextPage || page.EndCursor != cursorOf(modems[1]).encode() {
+		t.Errorf("full page: got %+v", page)
+	}
+
+	page = newPage(modems, 3, "")
+	if len(page.Modems) != 3 || page.HasNextPage {
+		t.Errorf("last page: got %+v", page)
+	}
+
+	page = newPage(nil, 3, "prev")
+	if len(page.Modems) != 0 || page.HasNextPage || page.EndCursor != "prev" {
+		t.Errorf("empty page: got %+v", page)
+	}
+}
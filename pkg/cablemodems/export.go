@@ -0,0 +1,97 @@
+package cablemodems
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var ErrUnknownField = errors.New("unknown cable modem field")
+
+// Fields selects CableModem fields by their json name, in the order they were asked for. Exports use it to
+// name their columns exactly as the JSON APIs name the same values, and to produce only the columns a client
+// wants.
+type Fields []field
+
+type field struct {
+	name  string
+	index int
+}
+
+// AllFields selects every CableModem field in declaration order, the columns of the .csv fixture format.
+func AllFields() Fields {
+	t := reflect.TypeOf(CableModem{})
+	fields := make(Fields, t.NumField())
+	for i := range fields {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		fields[i] = field{name, i}
+	}
+	return fields
+}
+
+// ParseFields selects the named fields, or every field when names is empty. Names are matched exactly and
+// may be given once only.
+func ParseFields(names []string) (Fields, error) {
+	if len(names) == 0 {
+		return AllFields(), nil
+	}
+	fields := make(Fields, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		i, ok := jsonFields[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownField, name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("field %q is selected twice", name)
+		}
+		seen[name] = true
+		fields = append(fields, field{name, i})
+	}
+	return fields, nil
+}
+
+// Names returns the json names of the selected fields, the header of a CSV export.
+func (f Fields) Names() []string {
+	names := make([]string, len(f))
+	for i, fd := range f {
+		names[i] = fd.name
+	}
+	return names
+}
+
+// Record fills record with the selected fields of m formatted as in a .csv fixture, an empty cell standing
+// for NULL, and returns it. record is reused when it has room, so a CSV export allocates one per stream.
+func (f Fields) Record(m *CableModem, record []string) []string {
+	record = record[:0]
+	v := reflect.ValueOf(m).Elem()
+	for _, fd := range f {
+		record = append(record, formatField(v.Field(fd.index)))
+	}
+	return record
+}
+
+// JSON returns m as a JSON object of the selected fields, in selection order. NULL columns are left out, as
+// in the JSON APIs.
+func (f Fields) JSON(m *CableModem) ([]byte, error) {
+	v := reflect.ValueOf(m).Elem()
+	b := []byte{'{'}
+	for _, fd := range f {
+		value := v.Field(fd.index)
+		if value.Kind() == reflect.Ptr && value.IsNil() {
+			continue
+		}
+		if len(b) > 1 {
+			b = append(b, ',')
+		}
+		b = append(b, fmt.Sprintf("%q:", fd.name)...)
+		encoded, err := json.Marshal(value.Interface())
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, encoded...)
+	}
+	return append(b, '}'), nil
+}
@@ -0,0 +1,59 @@
+package cablemodems
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseFields(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		names []string
+		want  []string
+		err   bool
+	}{
+		{nil, AllFields().Names(), false},
+		{[]string{"fqdn", "mac", "isCPE"}, []string{"fqdn", "mac", "isCPE"}, false},
+		{[]string{"mac", "nope"}, nil, true},
+		{[]string{"is_cpe"}, nil, true}, // a column name, not a json name
+		{[]string{"mac", "mac"}, nil, true},
+	} {
+		fields, err := ParseFields(tt.names)
+		if (err != nil) != tt.err {
+			t.Errorf("%v: got error %v", tt.names, err)
+			continue
+		}
+		if got := fields.Names(); err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v: got %v, want %v", tt.names, got, tt.want)
+		}
+	}
+	if _, err := ParseFields([]string{"nope"}); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("got %v, want ErrUnknownField", err)
+	}
+	if all := AllFields().Names(); all[0] != "mac" || all[len(all)-1] != "deviceType" || len(all) != len(jsonFields) {
+		t.Errorf("AllFields: %v", all)
+	}
+}
+
+func TestFieldsEncode(t *testing.T) {
+	t.Parallel()
+	fqdn, state, cpe := "acr01.den.example.net", Online, true
+	m := &CableModem{Mac: "5c:22:da:0e:9f:01", Fqdn: &fqdn, State: &state, IsCpe: &cpe}
+	fields, err := ParseFields([]string{"state", "mac", "ppod", "isCPE", "fqdn"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := fields.Record(m, nil), []string{"Online", "5c:22:da:0e:9f:01", "", "true", "acr01.den.example.net"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Record: got %q, want %q", got, want)
+	}
+	b, err := fields.JSON(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// selection order, NULL columns left out.
+	if got, want := string(b), `{"state":"Online","mac":"5c:22:da:0e:9f:01","isCPE":true,"fqdn":"acr01.den.example.net"}`; got != want {
+		t.Errorf("JSON: got %s, want %s", got, want)
+	}
+}
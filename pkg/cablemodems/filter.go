@@ -0,0 +1,117 @@
+package cablemodems
+
+import (
+	"strings"
+
+	"api-project/pkg/mac"
+)
+
+// apply adds a condition to w for every field set on f. A nil filter matches everything.
+func (f *Filter) apply(w *where) {
+	if f == nil {
+		return
+	}
+	if f.DocsisVersion != nil {
+		w.and("docsis_version = " + w.arg(string(*f.DocsisVersion)))
+	}
+	if f.DsInterface != nil {
+		w.and("mac_domain = " + w.arg(*f.DsInterface))
+	}
+	if f.Fqdn != nil {
+		w.and("fqdn = " + w.arg(*f.Fqdn))
+	}
+	if f.Ppod != nil {
+		w.and("ppod = " + w.arg(strings.ToUpper(*f.Ppod)))
+	}
+	if f.FiberNode != nil {
+		w.and("fiber_node = " + w.arg(*f.FiberNode))
+	}
+	if f.Transponder != nil {
+		if *f.Transponder {
+			w.and("transponder IS NOT NULL AND transponder != ''")
+		} else {
+			w.and("(transponder IS NULL OR transponder = '')")
+		}
+	}
+	if f.MacDomain != nil {
+		w.and("mac_domain = " + w.arg(*f.MacDomain))
+	}
+	if f.MacAddress != nil {
+		if f.MacAddress.Eq != nil {
+			w.and("mac = " + w.arg(*f.MacAddress.Eq))
+		}
+		if len(f.MacAddress.In) > 0 {
+			w.anyOf("mac", f.MacAddress.In)
+		}
+	}
+}
+
+// matches reports whether m satisfies every field set on f. It is the in-memory counterpart of apply.
+func (f *Filter) matches(m *CableModem) bool {
+	if f == nil {
+		return true
+	}
+	if f.DocsisVersion != nil && (m.DocsisVersion == nil || *m.DocsisVersion != *f.DocsisVersion) {
+		return false
+	}
+	if !equals(m.MacDomain, f.DsInterface) || !equals(m.Fqdn, f.Fqdn) || !equals(m.FiberNode, f.FiberNode) ||
+		!equals(m.MacDomain, f.MacDomain) {
+		return false
+	}
+	if f.Ppod != nil && (m.Ppod == nil || *m.Ppod != strings.ToUpper(*f.Ppod)) {
+		return false
+	}
+	if f.Transponder != nil && nonEmpty(m.Transponder) != *f.Transponder {
+		return false
+	}
+	if f.MacAddress != nil {
+		if f.MacAddress.Eq != nil && m.Mac != *f.MacAddress.Eq {
+			return false
+		}
+		if len(f.MacAddress.In) > 0 && !contains(f.MacAddress.In, m.Mac) {
+			return false
+		}
+	}
+	return true
+}
+
+// equals reports whether the column value v matches the optional filter value want; nil want matches anything.
+func equals(v, want *string) bool {
+	return want == nil || (v != nil && *v == *want)
+}
+
+func nonEmpty(v *string) bool {
+	return v != nil && *v != ""
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeMacs rewrites the mac address filter of f into the canonical form of package mac. It fails with a
+// *mac.InvalidError listing every entry that is not a MAC address.
+func (f *Filter) NormalizeMacs() error {
+	if f == nil || f.MacAddress == nil {
+		return nil
+	}
+	values := f.MacAddress.In
+	if f.MacAddress.Eq != nil {
+		values = append([]string{*f.MacAddress.Eq}, values...)
+	}
+	macs, err := mac.Normalize(values)
+	if err != nil {
+		return err
+	}
+	if f.MacAddress.Eq != nil {
+		f.MacAddress.Eq, macs = &macs[0], macs[1:]
+	}
+	if len(f.MacAddress.In) > 0 {
+		f.MacAddress.In = macs
+	}
+	return nil
+}
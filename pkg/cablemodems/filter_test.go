@@ -0,0 +1,66 @@
+package cablemodems
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"api-project/pkg/mac"
+
+	"github.com/lib/pq"
+)
+
+func TestFilterApply(t *testing.T) {
+	t.Parallel()
+	str := func(s string) *string { return &s }
+	docsis, no := Docsis31, false
+	f := &Filter{
+		DocsisVersion: &docsis,
+		DsInterface:   str("Cable1/0/0"),
+		Fqdn:          str("acr01.den.example.net"),
+		Ppod:          str("den01"),
+		FiberNode:     str("FN-12"),
+		Transponder:   &no,
+		MacDomain:     str("Cable1/0/0"),
+		MacAddress:    &StringFilterEqIn{Eq: str("a"), In: []string{"b", "c"}},
+	}
+	w := &where{}
+	f.apply(w)
+
+	want := " WHERE docsis_version = $1 AND mac_domain = $2 AND fqdn = $3 AND ppod = $4 AND fiber_node = $5" +
+		" AND (transponder IS NULL OR transponder = '') AND mac_domain = $6 AND mac = $7 AND mac = ANY($8)"
+	if got := w.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	wantArgs := []interface{}{"Docsis31", "Cable1/0/0", "acr01.den.example.net", "DEN01", "FN-12", "Cable1/0/0", "a", pq.Array([]string{"b", "c"})}
+	if !reflect.DeepEqual(w.args, wantArgs) {
+		t.Errorf("got args %v, want %v", w.args, wantArgs)
+	}
+
+	w = &where{}
+	(*Filter)(nil).apply(w)
+	if got := w.String(); got != "" {
+		t.Errorf("nil filter: got %q", got)
+	}
+}
+
+func TestFilterNormalizeMacs(t *testing.T) {
+	t.Parallel()
+	eq := "5C:22:DA:0E:9F:AB"
+	f := &Filter{MacAddress: &StringFilterEqIn{Eq: &eq, In: []string{"a4b1.e900.0001", "a4-b1-e9-00-00-02"}}}
+	if err := f.NormalizeMacs(); err != nil {
+		t.Fatal(err)
+	}
+	if *f.MacAddress.Eq != "5c:22:da:0e:9f:ab" || !reflect.DeepEqual(f.MacAddress.In, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}) {
+		t.Errorf("got %s %v", *f.MacAddress.Eq, f.MacAddress.In)
+	}
+
+	f = &Filter{MacAddress: &StringFilterEqIn{In: []string{"a4:b1:e9:00:00:01", "bogus"}}}
+	var invalid *mac.InvalidError
+	if err := f.NormalizeMacs(); !errors.As(err, &invalid) || !reflect.DeepEqual(invalid.Invalid, []string{"bogus"}) {
+		t.Errorf("got %v", err)
+	}
+	if err := (*Filter)(nil).NormalizeMacs(); err != nil {
+		t.Errorf("nil filter: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package cablemodems
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// LoadFixture reads the modems of a fixture file to seed a Memory with. A .json fixture is an array of
+// CableModem as the APIs return it; a .csv fixture has a header row naming CableModem json fields, in any order,
+// and an empty cell for a NULL column.
+func LoadFixture(path string) ([]*CableModem, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var modems []*CableModem
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		err = json.NewDecoder(f).Decode(&modems)
+	case ".csv":
+		modems, err = readCSV(f)
+	default:
+		return nil, fmt.Errorf("fixture %s: unknown format %q, want .json or .csv", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fixture %s: %w", path, err)
+	}
+	for i, m := range modems {
+		if m == nil || m.Mac == "" {
+			return nil, fmt.Errorf("fixture %s: modem %d has no mac", path, i+1)
+		}
+	}
+	return modems, nil
+}
+
+// jsonFields maps the json name of every CableModem field to its index.
+var jsonFields = func() map[string]int {
+	fields := map[string]int{}
+	t := reflect.TypeOf(CableModem{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		fields[name] = i
+	}
+	return fields
+}()
+
+func readCSV(r io.Reader) ([]*CableModem, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]int, len(header))
+	for i, name := range header {
+		f, ok := jsonFields[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		fields[i] = f
+	}
+
+	var modems []*CableModem
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return modems, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		m := &CableModem{}
+		v := reflect.ValueOf(m).Elem()
+		for i, cell := range record {
+			if err := setField(v.Field(fields[i]), cell); err != nil {
+				line, _ := cr.FieldPos(i)
+				return nil, fmt.Errorf("line %d, %s: %w", line, header[i], err)
+			}
+		}
+		modems = append(modems, m)
+	}
+}
+
+// setField parses cell into f, leaving pointer fields nil for an empty cell.
+func setField(f reflect.Value, cell string) error {
+	if f.Kind() == reflect.Ptr {
+		if cell == "" {
+			return nil
+		}
+		f.Set(reflect.New(f.Type().Elem()))
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(cell)
+	case reflect.Int32:
+		n, err := strconv.ParseInt(cell, 10, 32)
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type())
+	}
+	if e, ok := f.Interface().(interface{ IsValid() bool }); ok && !e.IsValid() {
+		return fmt.Errorf("invalid value %q", cell)
+	}
+	return nil
+}
+
+// WriteCSV writes modems in the .csv fixture format: a header of every CableModem json field, in field order.
+func WriteCSV(w io.Writer, modems []*CableModem) error {
+	fields := AllFields()
+	cw := csv.NewWriter(w)
+	if err := cw.Write(fields.Names()); err != nil {
+		return err
+	}
+	var record []string
+	for _, m := range modems {
+		record = fields.Record(m, record)
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatField is the inverse of setField.
+func formatField(f reflect.Value) string {
+	if f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.Int32:
+		return strconv.FormatInt(f.Int(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(f.Bool())
+	default:
+		return f.String()
+	}
+}
@@ -0,0 +1,242 @@
+package cablemodems
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TimeRange is the half-open interval [From, To) of a historical lookup.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// DefaultTimeRange is the range used when a client asks for none: the last day of minutely data
+// or the last week of hourly data.
+func DefaultTimeRange(period HistoricalPeriod, now time.Time) TimeRange {
+	if period == Hourly {
+		return TimeRange{From: now.Add(-7 * 24 * time.Hour), To: now}
+	}
+	return TimeRange{From: now.Add(-24 * time.Hour), To: now}
+}
+
+// ResolveTimeRange builds the range of a lookup from optional unix-second bounds, where 0 means unset.
+// A missing end defaults to now and a missing start to the DefaultTimeRange before the end.
+func ResolveTimeRange(period HistoricalPeriod, from, to int64, now time.Time) TimeRange {
+	rng := TimeRange{To: now}
+	if to != 0 {
+		rng.To = time.Unix(to, 0)
+	}
+	rng.From = DefaultTimeRange(period, rng.To).From
+	if from != 0 {
+		rng.From = time.Unix(from, 0)
+	}
+	return rng
+}
+
+func (r TimeRange) valid() bool { return r.From.Before(r.To) }
+
+// Recorder ingests the samples served by the historical lookups.
+type Recorder interface {
+	// RecordRegState stores the reg state of each device as sampled at the given time. Device.Time is ignored.
+	RecordRegState(ctx context.Context, at time.Time, devices []*TsRegStateDevice) error
+	// RecordCm stores the channel data of each device as sampled at the given time. Device.Time is ignored.
+	RecordCm(ctx context.Context, at time.Time, devices []*TsCmDevice) error
+}
+
+var _ Recorder = (*Postgres)(nil)
+
+// regStateTables maps a period to its rollup table. It doubles as the whitelist of table names that may be
+// formatted into a query.
+var regStateTables = map[HistoricalPeriod]struct{ table, trunc string }{
+	Minutely: {"ts_reg_state_minutely", "minute"},
+	Hourly:   {"ts_reg_state_hourly", "hour"},
+}
+
+func (p *Postgres) HistoricalRegState(ctx context.Context, macAddresses []string, period HistoricalPeriod, rng TimeRange) ([]*TsRegStateDevice, error) {
+	t, ok := regStateTables[period]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPeriod, period)
+	}
+	if len(macAddresses) == 0 {
+		return nil, ErrEmptyValues
+	}
+	if !rng.valid() {
+		return nil, ErrInvalidTimeRange
+	}
+
+	w := &where{}
+	w.anyOf("mac", macAddresses)
+	w.and("bucket >= " + w.arg(rng.From))
+	w.and("bucket < " + w.arg(rng.To))
+	db := p.conns.Reader(ctx)
+	if db == nil {
+		return nil, ErrNotReady
+	}
+	rows, err := db.QueryContext(ctx, "SELECT mac, bucket, reg_state FROM "+t.table+w.String()+" ORDER BY mac, bucket", w.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []*TsRegStateDevice
+	for rows.Next() {
+		var (
+			mac      string
+			bucket   time.Time
+			regState int32
+		)
+		if err := rows.Scan(&mac, &bucket, &regState); err != nil {
+			return nil, err
+		}
+		ts := int32(bucket.Unix())
+		devices = append(devices, &TsRegStateDevice{Mac: &mac, Time: &ts, RegState: &regState})
+	}
+	return devices, rows.Err()
+}
+
+func (p *Postgres) HistoricalCm(ctx context.Context, macAddresses []string, rng TimeRange) ([]*TsCmDevice, error) {
+	if len(macAddresses) == 0 {
+		return nil, ErrEmptyValues
+	}
+	if !rng.valid() {
+		return nil, ErrInvalidTimeRange
+	}
+
+	w := &where{}
+	w.anyOf("mac", macAddresses)
+	w.and("sampled_at >= " + w.arg(rng.From))
+	w.and("sampled_at < " + w.arg(rng.To))
+	db := p.conns.Reader(ctx)
+	if db == nil {
+		return nil, ErrNotReady
+	}
+	rows, err := db.QueryContext(ctx, `
+		SELECT mac, sampled_at, lost_sync, resets, cable_downstream, cable_upstream, cable_upstream_status, ofdm_downstream
+		FROM ts_cm`+w.String()+" ORDER BY mac, sampled_at", w.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []*TsCmDevice
+	for rows.Next() {
+		var (
+			d                                  TsCmDevice
+			mac                                string
+			sampledAt                          time.Time
+			down, up, upStatus, ofdmDownstream []byte
+		)
+		if err := rows.Scan(&mac, &sampledAt, &d.LostSync, &d.Resets, &down, &up, &upStatus, &ofdmDownstream); err != nil {
+			return nil, err
+		}
+		ts := int32(sampledAt.Unix())
+		d.Mac, d.Time = &mac, &ts
+		for _, col := range []struct {
+			raw []byte
+			dst interface{}
+		}{
+			{down, &d.CableDownstream},
+			{up, &d.CableUpstream},
+			{upStatus, &d.CableUpstreamStatus},
+			{ofdmDownstream, &d.OfdmDownstream},
+		} {
+			if col.raw == nil {
+				continue
+			}
+			if err := json.Unmarshal(col.raw, col.dst); err != nil {
+				return nil, fmt.Errorf("ts_cm %s at %s: %w", mac, sampledAt, err)
+			}
+		}
+		devices = append(devices, &d)
+	}
+	return devices, rows.Err()
+}
+
+func (p *Postgres) RecordRegState(ctx context.Context, at time.Time, devices []*TsRegStateDevice) error {
+	return p.inTx(ctx, func(tx *sql.Tx) error {
+		for _, t := range regStateTables {
+			// the bucket keeps whichever sample is the latest, so replays and out-of-order batches are harmless.
+			stmt, err := tx.PrepareContext(ctx, fmt.Sprintf(`
+				INSERT INTO %[1]s (mac, bucket, reg_state, sampled_at)
+				VALUES ($1, date_trunc('%[2]s', $2::timestamptz), $3, $2)
+				ON CONFLICT (mac, bucket) DO UPDATE
+				SET reg_state = EXCLUDED.reg_state, sampled_at = EXCLUDED.sampled_at
+				WHERE %[1]s.sampled_at <= EXCLUDED.sampled_at`, t.table, t.trunc))
+			if err != nil {
+				return err
+			}
+			defer stmt.Close()
+			for _, d := range devices {
+				if d == nil || d.Mac == nil || d.RegState == nil {
+					continue
+				}
+				if _, err := stmt.ExecContext(ctx, *d.Mac, at, *d.RegState); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (p *Postgres) RecordCm(ctx context.Context, at time.Time, devices []*TsCmDevice) error {
+	return p.inTx(ctx, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, `
+			INSERT INTO ts_cm (mac, sampled_at, lost_sync, resets, cable_downstream, cable_upstream, cable_upstream_status, ofdm_downstream)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (mac, sampled_at) DO UPDATE
+			SET lost_sync = EXCLUDED.lost_sync, resets = EXCLUDED.resets,
+			    cable_downstream = EXCLUDED.cable_downstream, cable_upstream = EXCLUDED.cable_upstream,
+			    cable_upstream_status = EXCLUDED.cable_upstream_status, ofdm_downstream = EXCLUDED.ofdm_downstream`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+		for _, d := range devices {
+			if d == nil || d.Mac == nil {
+				continue
+			}
+			args := []interface{}{*d.Mac, at, d.LostSync, d.Resets}
+			for _, v := range []interface{}{d.CableDownstream, d.CableUpstream, d.CableUpstreamStatus, d.OfdmDownstream} {
+				b, err := jsonColumn(v)
+				if err != nil {
+					return err
+				}
+				args = append(args, b)
+			}
+			if _, err := stmt.ExecContext(ctx, args...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// jsonColumn encodes a channel group for a JSONB column; empty groups are stored as NULL.
+func jsonColumn(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil || string(b) == "null" || string(b) == "[]" {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// inTx runs fn in a transaction on the writer, committing if it returns nil.
+func (p *Postgres) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	writer := p.conns.Writer(ctx)
+	if writer == nil {
+		return ErrNotReady
+	}
+	tx, err := writer.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,48 @@
+package cablemodems
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveTimeRange(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1_700_000_000, 0)
+	for _, tt := range []struct {
+		name     string
+		period   HistoricalPeriod
+		from, to int64
+		want     TimeRange
+	}{
+		{"minutely default", Minutely, 0, 0, TimeRange{now.Add(-24 * time.Hour), now}},
+		{"hourly default", Hourly, 0, 0, TimeRange{now.Add(-7 * 24 * time.Hour), now}},
+		{"default from before to", Minutely, 0, 1_600_000_000, TimeRange{time.Unix(1_600_000_000-86400, 0), time.Unix(1_600_000_000, 0)}},
+		{"explicit", Hourly, 1_600_000_000, 1_600_003_600, TimeRange{time.Unix(1_600_000_000, 0), time.Unix(1_600_003_600, 0)}},
+	} {
+		got := ResolveTimeRange(tt.period, tt.from, tt.to, now)
+		if !got.From.Equal(tt.want.From) || !got.To.Equal(tt.want.To) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if (TimeRange{From: now, To: now}).valid() {
+		t.Error("empty range should be invalid")
+	}
+}
+
+func TestJSONColumn(t *testing.T) {
+	t.Parallel()
+	idx := int32(3)
+	for _, tt := range []struct {
+		in   interface{}
+		want interface{}
+	}{
+		{[]*TsCableUpstream(nil), nil},
+		{[]*TsCableUpstream{}, nil},
+		{[]*TsCableUpstream{{IfIndex: &idx}}, `[{"ifIndex":3}]`},
+	} {
+		got, err := jsonColumn(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("%#v: got %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package cablemodems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"api-project/pkg/sqltest"
+)
+
+// TestHostileInputIsBound sends every hostile value through every lookup and write, and checks it only ever
+// reaches the database as a bind parameter.
+func TestHostileInputIsBound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	rng := DefaultTimeRange(Minutely, time.Now())
+	for _, tt := range []struct {
+		name string
+		call func(p *Postgres, v string) error
+	}{
+		{"ByMac", func(p *Postgres, v string) error {
+			_, err := p.ByMac(ctx, []string{v})
+			return err
+		}},
+		{"ByCmts", func(p *Postgres, v string) error {
+			_, err := p.ByCmts(ctx, CmtsQuery{Cmts: v})
+			return err
+		}},
+		{"ByCmts single", func(p *Postgres, v string) error {
+			_, err := p.ByCmts(ctx, CmtsQuery{Cmts: v, Single: true})
+			return err
+		}},
+		{"ByPoller", func(p *Postgres, v string) error {
+			_, err := p.ByPoller(ctx, PollerQuery{Poller: RxMer, Cmts: v})
+			return err
+		}},
+		{"Paged", func(p *Postgres, v string) error {
+			_, err := p.Paged(ctx, &Filter{Fqdn: &v, MacAddress: &StringFilterEqIn{In: []string{v, v}}}, 10, "")
+			return err
+		}},
+		{"Paged after", func(p *Postgres, v string) error {
+			_, err := p.Paged(ctx, nil, 10, cursor{Fqdn: v, Mac: v}.encode())
+			return err
+		}},
+		{"HistoricalRegState", func(p *Postgres, v string) error {
+			_, err := p.HistoricalRegState(ctx, []string{v}, Hourly, rng)
+			return err
+		}},
+		{"HistoricalCm", func(p *Postgres, v string) error {
+			_, err := p.HistoricalCm(ctx, []string{v}, rng)
+			return err
+		}},
+		{"RecordRegState", func(p *Postgres, v string) error {
+			regState := int32(6)
+			return p.RecordRegState(ctx, time.Now(), []*TsRegStateDevice{{Mac: &v, RegState: &regState}})
+		}},
+		{"Upsert", func(p *Postgres, v string) error {
+			_, err := p.Upsert(ctx, []*CableModem{{Mac: v, Fqdn: &v}}, nil)
+			return err
+		}},
+		{"MarkNotFound", func(p *Postgres, v string) error {
+			_, err := p.MarkNotFound(ctx, []string{v}, v, nil)
+			return err
+		}},
+		{"Update", func(p *Postgres, v string) error {
+			_, err := p.Update(ctx, []string{v}, &CableModem{CmtsType: &v}, nil)
+			return err
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, v := range sqltest.Hostile {
+				rec := sqltest.New(t)
+				if err := tt.call(NewPostgres(rec), v); err != nil {
+					t.Errorf("%q: %v", v, err)
+					continue
+				}
+				rec.AssertBound(t, v)
+			}
+		})
+	}
+}
+
+func TestByMacBindsOneArray(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	// more macs than a statement has bind parameters.
+	macs := make([]string, 70_000)
+	for i := range macs {
+		macs[i] = fmt.Sprintf("5c22da%06x", i)
+	}
+	if _, err := NewPostgres(rec).ByMac(context.Background(), macs); err != nil {
+		t.Fatal(err)
+	}
+	statements := rec.Statements()
+	if len(statements) != 1 || !strings.Contains(statements[0].Query, "WHERE mac = ANY($1)") {
+		t.Fatalf("got %d statements, first %q", len(statements), statements[0].Query)
+	}
+	if n := len(statements[0].Args); n != 1 {
+		t.Errorf("got %d bind parameters, want only the mac array", n)
+	}
+}
+
+func TestInRejectsUnfilterableColumn(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	err := NewPostgres(rec).in(context.Background(), "mac = mac OR 1", []string{"5c22da0e9fab"}, func(*CableModem) error { return nil })
+	if !errors.Is(err, ErrUnfilterableColumn) {
+		t.Fatalf("got %v, want ErrUnfilterableColumn", err)
+	}
+	if n := len(rec.Statements()); n != 0 {
+		t.Errorf("%d statements reached the database", n)
+	}
+}
+
+// FuzzByMac checks that the statement ByMac sends never depends on the mac it looks up.
+func FuzzByMac(f *testing.F) {
+	for _, v := range sqltest.Hostile {
+		f.Add(v)
+	}
+	want := statementsOf(f, func(p *Postgres) { p.ByMac(context.Background(), []string{"5c22da0e9fab"}) })
+	f.Fuzz(func(t *testing.T, mac string) {
+		got := statementsOf(t, func(p *Postgres) { p.ByMac(context.Background(), []string{mac}) })
+		if got != want {
+			t.Errorf("mac %q changed the statement to %q", mac, got)
+		}
+	})
+}
+
+// FuzzByCmts checks that the statement ByCmts sends depends on the CMTS only through its prefix.
+func FuzzByCmts(f *testing.F) {
+	for _, v := range sqltest.Hostile {
+		f.Add(v)
+	}
+	byCmts := func(cmts string) func(p *Postgres) {
+		return func(p *Postgres) { p.ByCmts(context.Background(), CmtsQuery{Cmts: cmts}) }
+	}
+	fqdnOnly, withPpod := statementsOf(f, byCmts("acr01")), statementsOf(f, byCmts("den01"))
+	f.Fuzz(func(t *testing.T, cmts string) {
+		if normalizeCmts(cmts) == "" {
+			return
+		}
+		want := withPpod
+		if hasFqdnOnlyPrefix(normalizeCmts(cmts)) {
+			want = fqdnOnly
+		}
+		if got := statementsOf(t, byCmts(cmts)); got != want {
+			t.Errorf("cmts %q changed the statement to %q", cmts, got)
+		}
+	})
+}
+
+// statementsOf returns the text of every statement call sends, joined.
+func statementsOf(t testing.TB, call func(p *Postgres)) string {
+	rec := sqltest.New(t)
+	call(NewPostgres(rec))
+	var s string
+	for _, st := range rec.Statements() {
+		s += st.Query + ";"
+	}
+	return s
+}
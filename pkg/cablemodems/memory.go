@@ -0,0 +1,380 @@
+package cablemodems
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Memory is a Repository over modems held in memory. It selects exactly the modems Postgres would, so tests
+// and local runs (see LoadFixture) behave like production without a database.
+type Memory struct {
+	mu       sync.RWMutex
+	modems   map[string]*CableModem
+	regState map[HistoricalPeriod]map[sample]regStateSample
+	cm       map[sample]*TsCmDevice
+}
+
+// sample identifies the samples of a modem at one instant: a rollup bucket or a poll.
+type sample struct {
+	mac string
+	at  int64
+}
+
+type regStateSample struct {
+	regState  int32
+	sampledAt time.Time
+}
+
+var (
+	_ Repository = (*Memory)(nil)
+	_ Recorder   = (*Memory)(nil)
+	_ Writer     = (*Memory)(nil)
+	_ Streamer   = (*Memory)(nil)
+)
+
+// NewMemory returns a Memory holding modems. A later modem replaces an earlier one with the same mac.
+func NewMemory(modems []*CableModem) *Memory {
+	m := &Memory{
+		modems:   make(map[string]*CableModem, len(modems)),
+		regState: map[HistoricalPeriod]map[sample]regStateSample{Minutely: {}, Hourly: {}},
+		cm:       map[sample]*TsCmDevice{},
+	}
+	for _, modem := range modems {
+		c := *modem
+		m.modems[modem.Mac] = &c
+	}
+	return m
+}
+
+func (m *Memory) ByMac(ctx context.Context, macAddresses []string) ([]*CableModem, error) {
+	if len(macAddresses) == 0 {
+		return nil, ErrEmptyValues
+	}
+	modems := m.filter(func(c *CableModem) bool { return contains(macAddresses, c.Mac) })
+	// ORDER BY fqdn: NULLs last.
+	sort.SliceStable(modems, func(i, j int) bool {
+		a, b := modems[i].Fqdn, modems[j].Fqdn
+		return a != nil && (b == nil || *a < *b)
+	})
+	return modems, nil
+}
+
+func (m *Memory) ByCmts(ctx context.Context, q CmtsQuery) ([]*CableModem, error) {
+	if normalizeCmts(q.Cmts) == "" {
+		return nil, ErrMissingCmts
+	}
+	modems := m.filter(func(c *CableModem) bool {
+		return behind(c, q.Cmts) && hasState(c, q.State) && hasDocsis(c, q.Docsis) && (!q.Single || reachable(c))
+	})
+	if q.Single && len(modems) > 1 {
+		modems = modems[:1]
+	}
+	return modems, nil
+}
+
+func (m *Memory) ByPoller(ctx context.Context, q PollerQuery) ([]*CableModem, error) {
+	rule, ok := pollerRules[q.Poller]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownPoller, q.Poller)
+	}
+	if normalizeCmts(q.Cmts) == "" {
+		return nil, ErrMissingCmts
+	}
+	return m.filter(func(c *CableModem) bool {
+		return behind(c, q.Cmts) && hasState(c, q.State) && hasDocsis(c, q.Docsis) && rule.matches(c)
+	}), nil
+}
+
+func (m *Memory) Paged(ctx context.Context, filter *Filter, first int, after string) (*Page, error) {
+	if first < 1 || first > MaxPageSize {
+		return nil, ErrInvalidFirst
+	}
+	modems, err := m.sorted(filter, after)
+	if err != nil {
+		return nil, err
+	}
+	if len(modems) > first+1 {
+		modems = modems[:first+1]
+	}
+	return newPage(modems, first, after), nil
+}
+
+func (m *Memory) EachByMac(ctx context.Context, macAddresses []string, fn func(*CableModem) error) error {
+	modems, err := m.ByMac(ctx, macAddresses)
+	return each(ctx, modems, err, fn)
+}
+
+func (m *Memory) EachByCmts(ctx context.Context, q CmtsQuery, fn func(*CableModem) error) error {
+	modems, err := m.ByCmts(ctx, q)
+	return each(ctx, modems, err, fn)
+}
+
+func (m *Memory) EachByPoller(ctx context.Context, q PollerQuery, fn func(*CableModem) error) error {
+	modems, err := m.ByPoller(ctx, q)
+	return each(ctx, modems, err, fn)
+}
+
+func (m *Memory) EachFiltered(ctx context.Context, filter *Filter, after string, fn func(*CableModem) error) error {
+	modems, err := m.sorted(filter, after)
+	return each(ctx, modems, err, fn)
+}
+
+// sorted returns the modems matching filter after the cursor after, in the order of Paged.
+func (m *Memory) sorted(filter *Filter, after string) ([]*CableModem, error) {
+	var from cursor
+	if after != "" {
+		c, err := decodeCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		from = c
+	}
+
+	modems := m.filter(func(c *CableModem) bool {
+		return filter.matches(c) && (after == "" || from.before(cursorOf(c)))
+	})
+	sort.Slice(modems, func(i, j int) bool { return cursorOf(modems[i]).before(cursorOf(modems[j])) })
+	return modems, nil
+}
+
+// each streams the result of a lookup like Postgres does, stopping once ctx is done.
+func each(ctx context.Context, modems []*CableModem, err error, fn func(*CableModem) error) error {
+	if err != nil {
+		return err
+	}
+	for _, c := range modems {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Memory) HistoricalRegState(ctx context.Context, macAddresses []string, period HistoricalPeriod, rng TimeRange) ([]*TsRegStateDevice, error) {
+	if _, ok := regStateTables[period]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPeriod, period)
+	}
+	if len(macAddresses) == 0 {
+		return nil, ErrEmptyValues
+	}
+	if !rng.valid() {
+		return nil, ErrInvalidTimeRange
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var devices []*TsRegStateDevice
+	for key, s := range m.regState[period] {
+		if contains(macAddresses, key.mac) && inRange(key.at, rng) {
+			mac, ts, regState := key.mac, int32(key.at), s.regState
+			devices = append(devices, &TsRegStateDevice{Mac: &mac, Time: &ts, RegState: &regState})
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		a, b := devices[i], devices[j]
+		return *a.Mac < *b.Mac || (*a.Mac == *b.Mac && *a.Time < *b.Time)
+	})
+	return devices, nil
+}
+
+func (m *Memory) HistoricalCm(ctx context.Context, macAddresses []string, rng TimeRange) ([]*TsCmDevice, error) {
+	if len(macAddresses) == 0 {
+		return nil, ErrEmptyValues
+	}
+	if !rng.valid() {
+		return nil, ErrInvalidTimeRange
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var devices []*TsCmDevice
+	for key, d := range m.cm {
+		if contains(macAddresses, key.mac) && inRange(key.at, rng) {
+			c := *d
+			devices = append(devices, &c)
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		a, b := devices[i], devices[j]
+		return *a.Mac < *b.Mac || (*a.Mac == *b.Mac && *a.Time < *b.Time)
+	})
+	return devices, nil
+}
+
+func (m *Memory) RecordRegState(ctx context.Context, at time.Time, devices []*TsRegStateDevice) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for period, t := range regStateTables {
+		unit := time.Minute
+		if t.trunc == "hour" {
+			unit = time.Hour
+		}
+		for _, d := range devices {
+			if d == nil || d.Mac == nil || d.RegState == nil {
+				continue
+			}
+			key := sample{*d.Mac, at.Truncate(unit).Unix()}
+			// like the upsert, the bucket keeps whichever sample is the latest.
+			if s, ok := m.regState[period][key]; ok && s.sampledAt.After(at) {
+				continue
+			}
+			m.regState[period][key] = regStateSample{*d.RegState, at}
+		}
+	}
+	return nil
+}
+
+func (m *Memory) RecordCm(ctx context.Context, at time.Time, devices []*TsCmDevice) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, d := range devices {
+		if d == nil || d.Mac == nil {
+			continue
+		}
+		c := *d
+		mac, ts := *d.Mac, int32(at.Unix())
+		c.Mac, c.Time = &mac, &ts
+		m.cm[sample{mac, at.Unix()}] = &c
+	}
+	return nil
+}
+
+func (m *Memory) Upsert(ctx context.Context, modems []*CableModem, versions Versions) ([]*CableModem, error) {
+	if err := uniqueMacs(modems); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := versions.conflicts(m.updatedAtTs); err != nil {
+		return nil, err
+	}
+	written := make([]*CableModem, 0, len(modems))
+	for _, modem := range modems {
+		c := *stamped(modem, now)
+		if old, ok := m.modems[c.Mac]; ok {
+			ts := nextVersion(old.UpdatedAtTs, *c.UpdatedAtTs)
+			c.UpdatedAtTs = &ts
+		}
+		m.modems[c.Mac] = &c
+		out := c
+		written = append(written, &out)
+	}
+	return byMac(written), nil
+}
+
+func (m *Memory) MarkNotFound(ctx context.Context, macAddresses []string, date string, versions Versions) ([]*CableModem, error) {
+	return m.update(macAddresses, versions, func(c *CableModem) { c.NotFoundDate = &date })
+}
+
+func (m *Memory) Restore(ctx context.Context, macAddresses []string, versions Versions) ([]*CableModem, error) {
+	return m.update(macAddresses, versions, func(c *CableModem) { c.NotFoundDate = nil })
+}
+
+func (m *Memory) Update(ctx context.Context, macAddresses []string, patch *CableModem, versions Versions) ([]*CableModem, error) {
+	columns, _ := patch.patchColumns()
+	if len(columns) == 0 {
+		return nil, ErrEmptyPatch
+	}
+	return m.update(macAddresses, versions, func(c *CableModem) {
+		dst, src := c.scanTargets(), patch.scanTargets()
+		for i, col := range cableModemColumns {
+			if contains(columns, col) {
+				reflect.ValueOf(dst[i]).Elem().Set(reflect.ValueOf(src[i]).Elem())
+			}
+		}
+	})
+}
+
+// update applies set, and the time of the write, to every given modem that exists and returns copies of them.
+func (m *Memory) update(macAddresses []string, versions Versions, set func(c *CableModem)) ([]*CableModem, error) {
+	if len(macAddresses) == 0 {
+		return nil, ErrEmptyValues
+	}
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := versions.conflicts(m.updatedAtTs); err != nil {
+		return nil, err
+	}
+	var updated []*CableModem
+	seen := map[string]bool{}
+	for _, mac := range macAddresses {
+		c, ok := m.modems[mac]
+		if !ok || seen[mac] {
+			continue
+		}
+		seen[mac] = true
+		set(c)
+		c.touch(now)
+		out := *c
+		updated = append(updated, &out)
+	}
+	return byMac(updated), nil
+}
+
+// updatedAtTs reads the version of a modem for Versions.conflicts. The caller holds mu.
+func (m *Memory) updatedAtTs(mac string) (*int32, bool) {
+	c, ok := m.modems[mac]
+	if !ok {
+		return nil, false
+	}
+	return c.UpdatedAtTs, true
+}
+
+// filter returns a copy of every modem keep accepts, ordered by mac.
+func (m *Memory) filter(keep func(c *CableModem) bool) []*CableModem {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	modems := []*CableModem{}
+	for _, c := range m.modems {
+		if keep(c) {
+			modem := *c
+			modems = append(modems, &modem)
+		}
+	}
+	sort.Slice(modems, func(i, j int) bool { return modems[i].Mac < modems[j].Mac })
+	return modems
+}
+
+// behind is the in-memory counterpart of where.cmts.
+func behind(c *CableModem, cmts string) bool {
+	cmts = normalizeCmts(cmts)
+	if !equals(c.Fqdn, &cmts) && !equals(c.Ppod, stringPtr(strings.ToUpper(cmts))) {
+		return false
+	}
+	return hasFqdnOnlyPrefix(cmts) || c.Ppod != nil
+}
+
+// reachable is the in-memory counterpart of where.reachable.
+func reachable(c *CableModem) bool {
+	if c.NotFoundDate != nil {
+		return false
+	}
+	ipv4 := nonEmpty(c.Ipv4) && *c.Ipv4 != "0.0.0.0"
+	ipv6 := nonEmpty(c.Ipv6) && *c.Ipv6 != "0000:0000:0000:0000:0000:0000:0000:0000"
+	return ipv4 || ipv6
+}
+
+func hasState(c *CableModem, state *State) bool {
+	return state == nil || (c.State != nil && *c.State == *state)
+}
+
+func hasDocsis(c *CableModem, docsis *DocsisVersion) bool {
+	return docsis == nil || (c.DocsisVersion != nil && *c.DocsisVersion == *docsis)
+}
+
+func inRange(unix int64, rng TimeRange) bool {
+	t := time.Unix(unix, 0)
+	return !t.Before(rng.From) && t.Before(rng.To)
+}
+
+func stringPtr(s string) *string { return &s }
@@ -0,0 +1,250 @@
+package cablemodems
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func loadMemory(t *testing.T) *Memory {
+	t.Helper()
+	modems, err := LoadFixture("testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewMemory(modems)
+}
+
+func macsOf(modems []*CableModem) []string {
+	macs := []string{}
+	for _, m := range modems {
+		macs = append(macs, m.Mac)
+	}
+	return macs
+}
+
+func TestMemoryLookups(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mem := loadMemory(t)
+	online, docsis31 := Online, Docsis31
+	for _, tt := range []struct {
+		name string
+		call func() ([]*CableModem, error)
+		want []string
+	}{
+		{"ByMac", func() ([]*CableModem, error) {
+			return mem.ByMac(ctx, []string{"a4:b1:e9:00:00:03", "5c:22:da:0e:9f:01", "ff:ff:ff:ff:ff:ff"})
+		}, []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"ByCmts fqdn", func() ([]*CableModem, error) {
+			return mem.ByCmts(ctx, CmtsQuery{Cmts: "acr01.den.example.net"})
+		}, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"ByCmts ppod", func() ([]*CableModem, error) {
+			return mem.ByCmts(ctx, CmtsQuery{Cmts: "den01"})
+		}, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"ByCmts fqdn without ppod", func() ([]*CableModem, error) {
+			return mem.ByCmts(ctx, CmtsQuery{Cmts: "den02.example.net"})
+		}, []string{}},
+		{"ByCmts state docsis", func() ([]*CableModem, error) {
+			return mem.ByCmts(ctx, CmtsQuery{Cmts: "acr01.den.example.net", State: &online, Docsis: &docsis31})
+		}, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}},
+		{"ByCmts single", func() ([]*CableModem, error) {
+			return mem.ByCmts(ctx, CmtsQuery{Cmts: "acr01.den.example.net", Single: true})
+		}, []string{"5c:22:da:0e:9f:01"}},
+		{"ByPoller RX_MER", func() ([]*CableModem, error) {
+			return mem.ByPoller(ctx, PollerQuery{Poller: RxMer, Cmts: "acr01.den.example.net"})
+		}, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}},
+		{"ByPoller MTA_INVENTORY", func() ([]*CableModem, error) {
+			return mem.ByPoller(ctx, PollerQuery{Poller: MtaInventory, Cmts: "DEN01"})
+		}, []string{"a4:b1:e9:00:00:01"}},
+		{"ByPoller REG_STATE", func() ([]*CableModem, error) {
+			return mem.ByPoller(ctx, PollerQuery{Poller: RegState, Cmts: "acr01.den.example.net"})
+		}, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03"}},
+		{"ByPoller HMS_TRAPS", func() ([]*CableModem, error) {
+			return mem.ByPoller(ctx, PollerQuery{Poller: HmsTraps, Cmts: "den01"})
+		}, []string{"a4:b1:e9:00:00:02"}},
+	} {
+		modems, err := tt.call()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := macsOf(modems); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryPaged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mem := loadMemory(t)
+
+	var got []string
+	after := ""
+	for pages := 0; ; pages++ {
+		page, err := mem.Paged(ctx, nil, 3, after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, macsOf(page.Modems)...)
+		after = page.EndCursor
+		if !page.HasNextPage {
+			break
+		}
+		if pages > 3 {
+			t.Fatal("paging does not end")
+		}
+	}
+	want := []string{
+		"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02", // no fqdn sorts first
+		"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04",
+		"a4:b1:e9:00:00:03",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	fn := "FN-102"
+	page, err := mem.Paged(ctx, &Filter{FiberNode: &fn}, 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := macsOf(page.Modems); !reflect.DeepEqual(got, []string{"5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}) {
+		t.Errorf("fiberNode: got %v", got)
+	}
+}
+
+func TestMemoryHistorical(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mem := loadMemory(t)
+	mac := "5c:22:da:0e:9f:01"
+	at := time.Date(2026, 10, 1, 12, 30, 0, 0, time.UTC)
+	for i, regState := range []int32{6, 1, 6} {
+		regState := regState
+		if err := mem.RecordRegState(ctx, at.Add(time.Duration(i)*time.Minute), []*TsRegStateDevice{{Mac: &mac, RegState: &regState}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rng := TimeRange{From: at.Add(-time.Hour), To: at.Add(time.Hour)}
+	minutely, err := mem.HistoricalRegState(ctx, []string{mac}, Minutely, rng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(minutely) != 3 || *minutely[1].RegState != 1 {
+		t.Errorf("minutely: got %d buckets", len(minutely))
+	}
+	hourly, err := mem.HistoricalRegState(ctx, []string{mac}, Hourly, rng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hourly) != 1 || *hourly[0].RegState != 6 || int64(*hourly[0].Time) != at.Truncate(time.Hour).Unix() {
+		t.Errorf("hourly: got %+v", hourly)
+	}
+
+	resets := int32(2)
+	if err := mem.RecordCm(ctx, at, []*TsCmDevice{{Mac: &mac, Resets: &resets}}); err != nil {
+		t.Fatal(err)
+	}
+	cm, err := mem.HistoricalCm(ctx, []string{mac}, rng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cm) != 1 || *cm[0].Resets != 2 || int64(*cm[0].Time) != at.Unix() {
+		t.Errorf("cm: got %+v", cm)
+	}
+}
+
+func TestMemoryWrites(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mem := loadMemory(t)
+
+	gone, err := mem.MarkNotFound(ctx, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:01", "ff:ff:ff:ff:ff:ff"}, "20261018", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := macsOf(gone); !reflect.DeepEqual(got, []string{"5c:22:da:0e:9f:01"}) {
+		t.Fatalf("MarkNotFound returned %v", got)
+	}
+	if gone[0].NotFoundDate == nil || *gone[0].NotFoundDate != "20261018" || gone[0].UpdatedAtTs == nil || *gone[0].UpdatedAtTs == 1760000000 {
+		t.Errorf("MarkNotFound left %+v", gone[0])
+	}
+	single, _ := mem.ByCmts(ctx, CmtsQuery{Cmts: "acr01.den.example.net", Single: true})
+	if got := macsOf(single); !reflect.DeepEqual(got, []string{"5c:22:da:0e:9f:02"}) {
+		t.Errorf("ByCmts single after MarkNotFound: %v", got)
+	}
+
+	restored, err := mem.Restore(ctx, []string{"5c:22:da:0e:9f:01"}, nil)
+	if err != nil || restored[0].NotFoundDate != nil {
+		t.Fatalf("Restore: %+v, %v", restored, err)
+	}
+
+	cmtsType, deviceType := "vcmts", int32(2)
+	updated, err := mem.Update(ctx, []string{"a4:b1:e9:00:00:02", "a4:b1:e9:00:00:01"}, &CableModem{Mac: "ignored", CmtsType: &cmtsType, DeviceType: &deviceType}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := macsOf(updated); !reflect.DeepEqual(got, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}) {
+		t.Fatalf("Update returned %v", got)
+	}
+	for _, m := range updated {
+		if *m.CmtsType != "vcmts" || *m.DeviceType != 2 || m.Ppod == nil {
+			t.Errorf("Update left %+v", m)
+		}
+	}
+	if _, err := mem.Update(ctx, []string{"a4:b1:e9:00:00:01"}, &CableModem{Mac: "a4:b1:e9:00:00:01"}, nil); !errors.Is(err, ErrEmptyPatch) {
+		t.Errorf("got %v, want ErrEmptyPatch", err)
+	}
+
+	fqdn := "acr02.den.example.net"
+	upserted, err := mem.Upsert(ctx, []*CableModem{{Mac: "5c:22:da:0e:9f:ff", Fqdn: &fqdn}}, nil)
+	if err != nil || len(upserted) != 1 || upserted[0].UpdatedAtTs == nil {
+		t.Fatalf("Upsert: %+v, %v", upserted, err)
+	}
+	if got, _ := mem.ByCmts(ctx, CmtsQuery{Cmts: fqdn}); !reflect.DeepEqual(macsOf(got), []string{"5c:22:da:0e:9f:ff"}) {
+		t.Errorf("ByCmts after Upsert: %v", macsOf(got))
+	}
+}
+
+func TestLoadFixtureCSV(t *testing.T) {
+	t.Parallel()
+	modems, err := LoadFixture("testdata/modems.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(modems) != 2 {
+		t.Fatalf("got %d modems", len(modems))
+	}
+	m := modems[0]
+	if *m.Fqdn != "acr01.den.example.net" || m.Ppod != nil || *m.DocsisVersion != Docsis31 || *m.RegState != 6 || *m.IsCpe {
+		t.Errorf("first modem: got %+v", m)
+	}
+	if m := modems[1]; m.Fqdn != nil || *m.Ppod != "DEN01" || m.RegState != nil || m.IsCpe != nil {
+		t.Errorf("second modem: got %+v", m)
+	}
+}
+
+func TestWriteCSVRoundTrip(t *testing.T) {
+	t.Parallel()
+	modems, err := LoadFixture("testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, modems); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, modems) {
+		t.Errorf("csv round trip changed the modems:\n%s", buf.String())
+	}
+}
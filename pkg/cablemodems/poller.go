@@ -0,0 +1,84 @@
+package cablemodems
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pollerRule describes which of the modems behind a CMTS a poller wants.
+// The rules of every PollerType live in pollerRules so that all three APIs select the same modems.
+type pollerRule struct {
+	// docsis restricts the modems to these DOCSIS versions. nil means any version.
+	docsis []DocsisVersion
+	// reachable requires a present modem with a usable IPv4 or IPv6 address; pollers that talk to the modem itself need one.
+	reachable bool
+	// present requires a modem that has not been marked not-found. implied by reachable.
+	present bool
+	// cpe requires a modem with a CPE/MTA behind it.
+	cpe bool
+	// transponder requires a modem attached to an HMS transponder.
+	transponder bool
+}
+
+var pollerRules = map[PollerType]pollerRule{
+	LowSpectrum:          {reachable: true},
+	FullSpectrum:         {reachable: true, docsis: []DocsisVersion{Docsis31, Docsis4}},
+	Cmts:                 {present: true},
+	CmCert:               {reachable: true},
+	RegState:             {present: true},
+	RxMer:                {reachable: true, docsis: []DocsisVersion{Docsis31, Docsis4}},
+	PowerSupply:          {reachable: true, transponder: true},
+	HmsTraps:             {present: true, transponder: true},
+	CmAttributeDiscovery: {reachable: true},
+	MtaInventory:         {reachable: true, cpe: true},
+}
+
+func (p PollerType) IsValid() bool {
+	_, ok := pollerRules[p]
+	return ok
+}
+
+// apply adds the rule's conditions to w.
+func (r pollerRule) apply(w *where) {
+	if len(r.docsis) > 0 {
+		placeholders := make([]string, len(r.docsis))
+		for i, d := range r.docsis {
+			placeholders[i] = w.arg(string(d))
+		}
+		w.and(fmt.Sprintf("docsis_version IN (%s)", strings.Join(placeholders, ", ")))
+	}
+	if r.reachable {
+		w.reachable()
+	} else if r.present {
+		w.and("not_found_date IS NULL")
+	}
+	if r.cpe {
+		w.and("cpe_mac IS NOT NULL AND cpe_mac != ''")
+	}
+	if r.transponder {
+		w.and("transponder IS NOT NULL AND transponder != ''")
+	}
+}
+
+// matches reports whether m satisfies the rule. It is the in-memory counterpart of apply.
+func (r pollerRule) matches(m *CableModem) bool {
+	if len(r.docsis) > 0 {
+		ok := false
+		for _, d := range r.docsis {
+			ok = ok || (m.DocsisVersion != nil && *m.DocsisVersion == d)
+		}
+		if !ok {
+			return false
+		}
+	}
+	if r.reachable && !reachable(m) {
+		return false
+	}
+	if r.present && m.NotFoundDate != nil {
+		return false
+	}
+	if r.cpe && !nonEmpty(m.CpeMac) {
+		return false
+	}
+	return !r.transponder || nonEmpty(m.Transponder)
+}
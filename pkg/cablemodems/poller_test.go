@@ -0,0 +1,44 @@
+package cablemodems
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPollerRules(t *testing.T) {
+	t.Parallel()
+	for _, p := range []PollerType{
+		LowSpectrum, FullSpectrum, Cmts, CmCert, RegState, RxMer, PowerSupply, HmsTraps, CmAttributeDiscovery, MtaInventory,
+	} {
+		if !p.IsValid() {
+			t.Errorf("%s: no selection rule", p)
+		}
+	}
+	if PollerType("SNMP").IsValid() {
+		t.Error("SNMP: expected no selection rule")
+	}
+}
+
+func TestPollerRuleApply(t *testing.T) {
+	t.Parallel()
+	w := &where{}
+	pollerRules[RxMer].apply(w)
+	if !reflect.DeepEqual(w.args, []interface{}{"Docsis31", "Docsis4"}) {
+		t.Errorf("RX_MER: got args %v", w.args)
+	}
+	if w.clauses[0] != "docsis_version IN ($1, $2)" || w.clauses[1] != "not_found_date IS NULL" {
+		t.Errorf("RX_MER: got %q", w.String())
+	}
+
+	w = &where{}
+	pollerRules[MtaInventory].apply(w)
+	if len(w.args) != 0 || w.clauses[len(w.clauses)-1] != "cpe_mac IS NOT NULL AND cpe_mac != ''" {
+		t.Errorf("MTA_INVENTORY: got %q", w.String())
+	}
+
+	w = &where{}
+	pollerRules[RegState].apply(w)
+	if got := w.String(); got != " WHERE not_found_date IS NULL" {
+		t.Errorf("REG_STATE: got %q", got)
+	}
+}
@@ -0,0 +1,235 @@
+package cablemodems
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Conns hands out the pool each statement runs against. It is asked once per statement, with the context of
+// the call, so that an implementation can route reads between replicas and send a caller's reads after its own
+// writes to the primary.
+// Either may return nil while the database is unreachable.
+type Conns interface {
+	Reader(ctx context.Context) *sql.DB
+	// Writer is only asked for right before a write.
+	Writer(ctx context.Context) *sql.DB
+}
+
+// Postgres is the Repository backed by the cablemodems table and the ts_* time-series tables.
+type Postgres struct {
+	conns Conns
+}
+
+var (
+	_ Repository = (*Postgres)(nil)
+	_ Streamer   = (*Postgres)(nil)
+)
+
+// NewPostgres returns a Repository that reads and writes through conns.
+func NewPostgres(conns Conns) *Postgres {
+	return &Postgres{conns: conns}
+}
+
+func (p *Postgres) ByMac(ctx context.Context, macAddresses []string) ([]*CableModem, error) {
+	return collect(func(fn func(*CableModem) error) error { return p.EachByMac(ctx, macAddresses, fn) })
+}
+
+func (p *Postgres) ByCmts(ctx context.Context, q CmtsQuery) ([]*CableModem, error) {
+	return collect(func(fn func(*CableModem) error) error { return p.EachByCmts(ctx, q, fn) })
+}
+
+func (p *Postgres) ByPoller(ctx context.Context, q PollerQuery) ([]*CableModem, error) {
+	return collect(func(fn func(*CableModem) error) error { return p.EachByPoller(ctx, q, fn) })
+}
+
+func (p *Postgres) Paged(ctx context.Context, filter *Filter, first int, after string) (*Page, error) {
+	if first < 1 || first > MaxPageSize {
+		return nil, ErrInvalidFirst
+	}
+	w, err := filtered(filter, after)
+	if err != nil {
+		return nil, err
+	}
+
+	// one extra row tells us whether there is a next page.
+	query := selectCableModems + w.String() + " ORDER BY COALESCE(fqdn, ''), mac LIMIT " + w.arg(first+1)
+	modems, err := collect(func(fn func(*CableModem) error) error { return p.each(ctx, query, w.args, fn) })
+	if err != nil {
+		return nil, err
+	}
+	return newPage(modems, first, after), nil
+}
+
+func (p *Postgres) EachByMac(ctx context.Context, macAddresses []string, fn func(*CableModem) error) error {
+	return p.in(ctx, "mac", macAddresses, fn)
+}
+
+func (p *Postgres) EachByCmts(ctx context.Context, q CmtsQuery, fn func(*CableModem) error) error {
+	if normalizeCmts(q.Cmts) == "" {
+		return ErrMissingCmts
+	}
+
+	w := &where{}
+	w.cmts(q.Cmts)
+	if q.State != nil {
+		w.and("state = " + w.arg(string(*q.State)))
+	}
+	if q.Docsis != nil {
+		w.and("docsis_version = " + w.arg(string(*q.Docsis)))
+	}
+
+	if q.Single {
+		w.reachable()
+		return p.each(ctx, selectCableModems+w.String()+" LIMIT 1", w.args, fn)
+	}
+	return p.each(ctx, selectCableModems+w.String()+" ORDER BY mac", w.args, fn)
+}
+
+func (p *Postgres) EachByPoller(ctx context.Context, q PollerQuery, fn func(*CableModem) error) error {
+	rule, ok := pollerRules[q.Poller]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownPoller, q.Poller)
+	}
+	if normalizeCmts(q.Cmts) == "" {
+		return ErrMissingCmts
+	}
+
+	w := &where{}
+	w.cmts(q.Cmts)
+	if q.State != nil {
+		w.and("state = " + w.arg(string(*q.State)))
+	}
+	if q.Docsis != nil {
+		w.and("docsis_version = " + w.arg(string(*q.Docsis)))
+	}
+	rule.apply(w)
+
+	return p.each(ctx, selectCableModems+w.String()+" ORDER BY mac", w.args, fn)
+}
+
+func (p *Postgres) EachFiltered(ctx context.Context, filter *Filter, after string, fn func(*CableModem) error) error {
+	w, err := filtered(filter, after)
+	if err != nil {
+		return err
+	}
+	return p.each(ctx, selectCableModems+w.String()+" ORDER BY COALESCE(fqdn, ''), mac", w.args, fn)
+}
+
+// filtered returns the conditions of the modems matching filter that sort after the cursor after.
+func filtered(filter *Filter, after string) (*where, error) {
+	w := &where{}
+	filter.apply(w)
+	if after != "" {
+		c, err := decodeCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		w.and(fmt.Sprintf("(COALESCE(fqdn, ''), mac) > (%s, %s)", w.arg(c.Fqdn), w.arg(c.Mac)))
+	}
+	return w, nil
+}
+
+// in calls fn for every modem whose column equals one of values. column must be in filterableColumns and
+// values are bound as a single array, so neither can inject SQL and any number of values fits in one
+// statement.
+func (p *Postgres) in(ctx context.Context, column string, values []string, fn func(*CableModem) error) error {
+	if len(values) == 0 {
+		return ErrEmptyValues
+	}
+	if !filterableColumns[column] {
+		return fmt.Errorf("%w: %s", ErrUnfilterableColumn, column)
+	}
+
+	w := &where{}
+	w.anyOf(column, values)
+	return p.each(ctx, selectCableModems+w.String()+" ORDER BY fqdn", w.args, fn)
+}
+
+// each runs a selectCableModems query and calls fn with every row as soon as it is scanned. The query is
+// cancelled with ctx, for instance when the client of a streamed response goes away.
+func (p *Postgres) each(ctx context.Context, query string, args []interface{}, fn func(*CableModem) error) error {
+	db := p.conns.Reader(ctx)
+	if db == nil {
+		return ErrNotReady
+	}
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		cablemodem, err := scanCableModem(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(cablemodem); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// where accumulates the AND-ed conditions of a query together with their bind parameters.
+type where struct {
+	clauses []string
+	args    []interface{}
+}
+
+// arg binds v and returns its placeholder.
+func (w *where) arg(v interface{}) string {
+	w.args = append(w.args, v)
+	return fmt.Sprintf("$%d", len(w.args))
+}
+
+func (w *where) and(clause string) {
+	w.clauses = append(w.clauses, clause)
+}
+
+// anyOf matches column against every value, bound as one text[] parameter rather than one parameter per
+// value, so that lists past the 65535 bind parameters of a statement still fit.
+func (w *where) anyOf(column string, values []string) {
+	w.and(column + " = ANY(" + w.arg(pq.Array(values)) + ")")
+}
+
+// cmts matches the modems of a CMTS by fqdn or ppod. fqdns are stored lower case and ppods upper case.
+// Only the acr/cbr/smi CMTS families are addressed purely by fqdn; every other CMTS must also have a ppod.
+func (w *where) cmts(cmts string) {
+	cmts = normalizeCmts(cmts)
+	w.and(fmt.Sprintf("(fqdn = %s OR ppod = %s)", w.arg(cmts), w.arg(strings.ToUpper(cmts))))
+	if !hasFqdnOnlyPrefix(cmts) {
+		w.and("ppod IS NOT NULL")
+	}
+}
+
+// reachable restricts the query to present modems with a usable IPv4 or IPv6 address.
+func (w *where) reachable() {
+	w.and("not_found_date IS NULL")
+	w.and(`(
+		(ipv4 IS NOT NULL AND ipv4 != '0.0.0.0' AND ipv4 != '')
+		OR
+		(ipv6 IS NOT NULL AND ipv6 != '0000:0000:0000:0000:0000:0000:0000:0000' AND ipv6 != '')
+	)`)
+}
+
+func (w *where) String() string {
+	if len(w.clauses) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.clauses, " AND ")
+}
+
+// normalizeCmts spells a CMTS as its fqdn is stored, so that neither the family prefix check nor the match
+// depends on how the caller cased it.
+func normalizeCmts(cmts string) string {
+	return strings.ToLower(strings.TrimSpace(cmts))
+}
+
+// hasFqdnOnlyPrefix reports whether a normalized CMTS belongs to a family addressed purely by fqdn.
+func hasFqdnOnlyPrefix(cmts string) bool {
+	return strings.HasPrefix(cmts, "acr") || strings.HasPrefix(cmts, "cbr") || strings.HasPrefix(cmts, "smi")
+}
@@ -0,0 +1,33 @@
+package cablemodems
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWhereCmts(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		cmts string
+		want string
+		args []interface{}
+	}{
+		{"acr01.den.example.net", " WHERE (fqdn = $1 OR ppod = $2)", []interface{}{"acr01.den.example.net", "ACR01.DEN.EXAMPLE.NET"}},
+		{"cbr8-02", " WHERE (fqdn = $1 OR ppod = $2)", []interface{}{"cbr8-02", "CBR8-02"}},
+		{"smi-3", " WHERE (fqdn = $1 OR ppod = $2)", []interface{}{"smi-3", "SMI-3"}},
+		{"den01ppod", " WHERE (fqdn = $1 OR ppod = $2) AND ppod IS NOT NULL", []interface{}{"den01ppod", "DEN01PPOD"}},
+		// the prefix check and the match see the CMTS as it is stored, however the caller cased it.
+		{" ACR01.Den.Example.NET ", " WHERE (fqdn = $1 OR ppod = $2)", []interface{}{"acr01.den.example.net", "ACR01.DEN.EXAMPLE.NET"}},
+		{"CBR8-02", " WHERE (fqdn = $1 OR ppod = $2)", []interface{}{"cbr8-02", "CBR8-02"}},
+		{"Den01PPOD", " WHERE (fqdn = $1 OR ppod = $2) AND ppod IS NOT NULL", []interface{}{"den01ppod", "DEN01PPOD"}},
+	} {
+		w := &where{}
+		w.cmts(tt.cmts)
+		if got := w.String(); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.cmts, got, tt.want)
+		}
+		if !reflect.DeepEqual(w.args, tt.args) {
+			t.Errorf("%s: got args %v, want %v", tt.cmts, w.args, tt.args)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package cablemodems
+
+import "context"
+
+// Streamer runs the lookups that can return a large part of the plant without holding their result: each
+// method calls fn with every modem, in the order of its Repository counterpart, as soon as it is read. A
+// response can then be written while the query is still running.
+//
+// Streaming stops at the first error, whether from the query, from fn or from ctx being done, and returns it.
+type Streamer interface {
+	EachByMac(ctx context.Context, macAddresses []string, fn func(*CableModem) error) error
+	EachByCmts(ctx context.Context, q CmtsQuery, fn func(*CableModem) error) error
+	EachByPoller(ctx context.Context, q PollerQuery, fn func(*CableModem) error) error
+	// EachFiltered goes through every modem matching filter in the order of Paged, starting after the cursor of
+	// a previous page or streamed modem (see Cursor), without splitting them into pages.
+	EachFiltered(ctx context.Context, filter *Filter, after string, fn func(*CableModem) error) error
+}
+
+// Cursor returns the Paged cursor positioned on m, so that a client can resume a stream after the last modem
+// it received.
+func Cursor(m *CableModem) string {
+	return cursorOf(m).encode()
+}
+
+// collect gathers what a Streamer method streams. It never returns a nil slice with a nil error.
+func collect(each func(fn func(*CableModem) error) error) ([]*CableModem, error) {
+	modems := []*CableModem{}
+	err := each(func(m *CableModem) error {
+		modems = append(modems, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return modems, nil
+}
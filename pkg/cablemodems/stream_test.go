@@ -0,0 +1,90 @@
+package cablemodems
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"api-project/pkg/sqltest"
+)
+
+func TestMemoryStreams(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mem := loadMemory(t)
+
+	var got []string
+	var after string
+	err := mem.EachFiltered(ctx, nil, "", func(m *CableModem) error {
+		got = append(got, m.Mac)
+		if len(got) == 3 {
+			after = Cursor(m)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := mem.Paged(ctx, nil, MaxPageSize, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := macsOf(page.Modems); !reflect.DeepEqual(got, want) {
+		t.Errorf("EachFiltered: got %v, want the order of Paged %v", got, want)
+	}
+
+	// the cursor of a streamed modem resumes the stream right after it.
+	var resumed []string
+	if err := mem.EachFiltered(ctx, nil, after, func(m *CableModem) error {
+		resumed = append(resumed, m.Mac)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resumed, got[3:]) {
+		t.Errorf("resumed at %v, want %v", resumed, got[3:])
+	}
+
+	stop := errors.New("stop")
+	var n int
+	err = mem.EachByCmts(ctx, CmtsQuery{Cmts: "acr01.den.example.net"}, func(*CableModem) error {
+		n++
+		return stop
+	})
+	if !errors.Is(err, stop) || n != 1 {
+		t.Errorf("got %v after %d modems, want the error of fn after the first", err, n)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	err = mem.EachByMac(cancelled, []string{"5c:22:da:0e:9f:01"}, func(*CableModem) error {
+		t.Error("streamed after the context was cancelled")
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+
+	if err := mem.EachByPoller(ctx, PollerQuery{Poller: "SNMP", Cmts: "den01"}, nil); !errors.Is(err, ErrUnknownPoller) {
+		t.Errorf("got %v, want ErrUnknownPoller", err)
+	}
+}
+
+func TestEachFilteredIsNotPaged(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	err := NewPostgres(rec).EachFiltered(context.Background(), nil, cursor{Mac: "5c:22:da:0e:9f:01"}.encode(), func(*CableModem) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	statements := rec.Statements()
+	if len(statements) != 1 {
+		t.Fatalf("got %d statements", len(statements))
+	}
+	q := statements[0].Query
+	if !strings.HasSuffix(q, "WHERE (COALESCE(fqdn, ''), mac) > ($1, $2) ORDER BY COALESCE(fqdn, ''), mac") {
+		t.Errorf("got %q", q)
+	}
+}
@@ -0,0 +1,200 @@
+package cablemodems
+
+// CableModem is a single row of the cablemodems table. It is the one representation of a modem shared by the
+// REST, gRPC and GraphQL APIs; each API converts from it at its edge.
+// The db tags name the column behind each field and are the only column list the queries use.
+type CableModem struct {
+	Mac                string         `json:"mac" db:"mac"`
+	CpeMac             *string        `json:"cpeMac,omitempty" db:"cpe_mac"`
+	MacDomain          *string        `json:"macDomain,omitempty" db:"mac_domain"`
+	CableModemIndex    *int32         `json:"cableModemIndex,omitempty" db:"cable_modem_index"`
+	ConfigFile         *string        `json:"configFile,omitempty" db:"config_file"`
+	Model              *string        `json:"model,omitempty" db:"model"`
+	FiberNode          *string        `json:"fiberNode,omitempty" db:"fiber_node"`
+	Ipv4               *string        `json:"ipv4,omitempty" db:"ipv4"`
+	Ipv6               *string        `json:"ipv6,omitempty" db:"ipv6"`
+	CpeIpv4            *string        `json:"cpeIpv4,omitempty" db:"cpe_ipv4"`
+	Transponder        *string        `json:"transponder,omitempty" db:"transponder"`
+	DocsisVersion      *DocsisVersion `json:"docsisVersion,omitempty" db:"docsis_version"`
+	Ppod               *string        `json:"ppod,omitempty" db:"ppod"`
+	Fqdn               *string        `json:"fqdn,omitempty" db:"fqdn"`
+	State              *State         `json:"state,omitempty" db:"state"`
+	NotFoundDate       *string        `json:"notFoundDate,omitempty" db:"not_found_date"`
+	RegState           *int32         `json:"regState,omitempty" db:"reg_state"`
+	FnName             *string        `json:"fnName,omitempty" db:"fn_name"`
+	NumberOfGenerators *int32         `json:"numberOfGenerators,omitempty" db:"number_of_generators"`
+	RpdName            *string        `json:"rpdName,omitempty" db:"rpd_name"`
+	UpdatedAt          *string        `json:"updatedAt,omitempty" db:"updated_at"`
+	Bootr              *string        `json:"bootr,omitempty" db:"bootr"`
+	Vendor             *string        `json:"vendor,omitempty" db:"vendor"`
+	SwRev              *string        `json:"swRev,omitempty" db:"sw_rev"`
+	OltName            *string        `json:"oltName,omitempty" db:"olt_name"`
+	PonName            *string        `json:"ponName,omitempty" db:"pon_name"`
+	UpdatedAtTs        *int32         `json:"updatedAtTs,omitempty" db:"updated_at_ts"`
+	IsCpe              *bool          `json:"isCPE,omitempty" db:"is_cpe"`
+	CmtsType           *string        `json:"cmtsType,omitempty" db:"cmts_type"`
+	// This attribute represents the current type of device. metroe(1)
+	DeviceType *int32 `json:"deviceType,omitempty" db:"device_type"`
+}
+
+// DocsisVersion is stored in the docsis_version column exactly as the GraphQL enum spells it.
+type DocsisVersion string
+
+const (
+	Docsis3  DocsisVersion = "Docsis3"
+	Docsis31 DocsisVersion = "Docsis31"
+	Docsis4  DocsisVersion = "Docsis4"
+)
+
+func (d DocsisVersion) IsValid() bool {
+	switch d {
+	case Docsis3, Docsis31, Docsis4:
+		return true
+	}
+	return false
+}
+
+// State is stored in the state column exactly as the GraphQL enum spells it.
+type State string
+
+const (
+	Online  State = "Online"
+	Offline State = "Offline"
+)
+
+func (s State) IsValid() bool {
+	switch s {
+	case Online, Offline:
+		return true
+	}
+	return false
+}
+
+// PollerType names a poller that asks for the set of modems it should poll on a CMTS.
+type PollerType string
+
+const (
+	LowSpectrum          PollerType = "LOW_SPECTRUM"
+	FullSpectrum         PollerType = "FULL_SPECTRUM"
+	Cmts                 PollerType = "CMTS"
+	CmCert               PollerType = "CM_CERT"
+	RegState             PollerType = "REG_STATE"
+	RxMer                PollerType = "RX_MER"
+	PowerSupply          PollerType = "POWER_SUPPLY"
+	HmsTraps             PollerType = "HMS_TRAPS"
+	CmAttributeDiscovery PollerType = "CM_ATTRIBUTE_DISCOVERY"
+	MtaInventory         PollerType = "MTA_INVENTORY"
+)
+
+// HistoricalPeriod is the bucket size of historical reg-state data.
+type HistoricalPeriod string
+
+const (
+	Minutely HistoricalPeriod = "Minutely"
+	Hourly   HistoricalPeriod = "Hourly"
+)
+
+// CmtsQuery selects the modems behind a CMTS, identified by fqdn or ppod.
+type CmtsQuery struct {
+	Cmts   string
+	State  *State
+	Docsis *DocsisVersion
+	// Single returns at most one reachable modem.
+	Single bool
+}
+
+// PollerQuery selects the modems a poller of the given type should poll on a CMTS.
+type PollerQuery struct {
+	Poller PollerType
+	Cmts   string
+	State  *State
+	Docsis *DocsisVersion
+}
+
+// StringFilterEqIn matches a column either against a single value or a set of values.
+type StringFilterEqIn struct {
+	Eq *string
+	In []string
+}
+
+// Filter mirrors the GraphQL CableModemsFilter input. Every set field narrows the result.
+type Filter struct {
+	DocsisVersion *DocsisVersion
+	// DsInterface matches mac_domain: the table only records a modem's downstream interface as the name of the
+	// MAC domain it belongs to (e.g. Cable1/0/0).
+	DsInterface *string
+	Fqdn        *string
+	Ppod        *string
+	FiberNode   *string
+	// Transponder selects modems with (true) or without (false) an HMS transponder.
+	Transponder *bool
+	MacDomain   *string
+	MacAddress  *StringFilterEqIn
+}
+
+// Page is one page of a Paged lookup.
+type Page struct {
+	Modems      []*CableModem
+	EndCursor   string
+	HasNextPage bool
+}
+
+type TsRegStateDevice struct {
+	Mac      *string `json:"mac,omitempty"`
+	Time     *int32  `json:"time,omitempty"`
+	RegState *int32  `json:"regState,omitempty"`
+}
+
+type TsCmDevice struct {
+	Mac                 *string                  `json:"mac,omitempty"`
+	Time                *int32                   `json:"time,omitempty"`
+	LostSync            *int32                   `json:"lostSync,omitempty"`
+	Resets              *int32                   `json:"resets,omitempty"`
+	CableDownstream     []*TsCableDownstream     `json:"cableDownstream,omitempty"`
+	CableUpstream       []*TsCableUpstream       `json:"cableUpstream,omitempty"`
+	CableUpstreamStatus []*TsCableUpstreamStatus `json:"cableUpstreamStatus,omitempty"`
+	OfdmDownstream      []*TsOfdmDownstream      `json:"ofdmDownstream,omitempty"`
+}
+
+type TsCableDownstream struct {
+	IfIndex            *int32  `json:"ifIndex,omitempty"`
+	ChannelPower       *string `json:"channelPower,omitempty"`
+	Unerroreds         *string `json:"unerroreds,omitempty"`
+	Correcteds         *string `json:"correcteds,omitempty"`
+	Uncorrectables     *string `json:"uncorrectables,omitempty"`
+	SignalNoiseDecibel *string `json:"signalNoiseDecibel,omitempty"`
+}
+
+type TsCableUpstream struct {
+	IfIndex          *int32  `json:"ifIndex,omitempty"`
+	StatusTxPower    *string `json:"statusTxPower,omitempty"`
+	StatusT3Timeouts *int32  `json:"statusT3Timeouts,omitempty"`
+	StatusT4Timeouts *int32  `json:"statusT4Timeouts,omitempty"`
+}
+
+type TsCableUpstreamStatus struct {
+	IfDescr *string `json:"ifDescr,omitempty"`
+	Snr     *string `json:"snr,omitempty"`
+	RxPower *string `json:"rxPower,omitempty"`
+}
+
+type TsOfdmDownstream struct {
+	IfIndex            *int32                         `json:"ifIndex,omitempty"`
+	RxMerMean          *string                        `json:"rxMerMean,omitempty"`
+	RxMer2ndPercentile *string                        `json:"rxMer2ndPercentile,omitempty"`
+	ProfileStats       []*TsCmOfdmChannelProfileStats `json:"profileStats,omitempty"`
+	OfdmDsChannelPower []*TsCmOfdmChannelPower        `json:"ofdmDsChannelPower,omitempty"`
+}
+
+type TsCmOfdmChannelProfileStats struct {
+	CmtsProfileID         *int32  `json:"cmtsProfileId,omitempty"`
+	CorrectedCodewords    *string `json:"correctedCodewords,omitempty"`
+	UncorrectableCodeword *string `json:"uncorrectableCodeword,omitempty"`
+	TotalCodewords        *string `json:"totalCodewords,omitempty"`
+}
+
+type TsCmOfdmChannelPower struct {
+	ChannelBandIndex *int32  `json:"channelBandIndex,omitempty"`
+	CenterFrequency  *int32  `json:"centerFrequency,omitempty"`
+	RxPower          *string `json:"rxPower,omitempty"`
+}
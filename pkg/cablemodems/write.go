@@ -0,0 +1,293 @@
+package cablemodems
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// upsertBatch is how many modems one upsert statement carries, which keeps it well under the 65535 bind
+// parameters Postgres accepts.
+const upsertBatch = 1000
+
+// Writer changes cable modems. Every call runs in one transaction on the writer and returns the modems as it
+// left them, ordered by mac. Macs that do not exist are skipped rather than reported.
+//
+// A call given Versions is conditional: it writes nothing and returns a *ConflictError unless every modem
+// in versions still has the updated_at_ts its writer last read. Nil versions write unconditionally.
+type Writer interface {
+	// Upsert inserts modems, replacing every column of the ones whose mac already exists. It fails with
+	// ErrDuplicateMac, writing nothing, if modems give one mac twice. A modem without
+	// UpdatedAtTs is stamped with the time of the write. Every write advances the updated_at_ts of an existing
+	// modem, whatever the stamp it carries.
+	Upsert(ctx context.Context, modems []*CableModem, versions Versions) ([]*CableModem, error)
+	// MarkNotFound sets the not-found date, YYYYMMDD, of the given modems.
+	MarkNotFound(ctx context.Context, macAddresses []string, date string, versions Versions) ([]*CableModem, error)
+	// Restore clears the not-found date of the given modems.
+	Restore(ctx context.Context, macAddresses []string, versions Versions) ([]*CableModem, error)
+	// Update sets every non-nil column of patch on the given modems. patch.Mac and the update times are
+	// ignored; MarkNotFound, Restore and Update all stamp the time of the write themselves.
+	Update(ctx context.Context, macAddresses []string, patch *CableModem, versions Versions) ([]*CableModem, error)
+}
+
+// Versions maps macs to the updated_at_ts their writer last read.
+type Versions map[string]int32
+
+// ConflictError lists the modems of a conditional write that changed, or vanished, since their writer read
+// them.
+type ConflictError struct {
+	Conflicts []string
+}
+
+func (e *ConflictError) Error() string {
+	return "cable modems changed since they were read: " + strings.Join(e.Conflicts, ", ")
+}
+
+// conflicts returns a *ConflictError for the macs of versions whose stored updated_at_ts, as read by
+// current, differs. current reports false for a modem that does not exist.
+func (v Versions) conflicts(current func(mac string) (*int32, bool)) error {
+	var conflicts []string
+	for mac, want := range v {
+		if ts, ok := current(mac); !ok || ts == nil || *ts != want {
+			conflicts = append(conflicts, mac)
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	sort.Strings(conflicts)
+	return &ConflictError{Conflicts: conflicts}
+}
+
+var _ Writer = (*Postgres)(nil)
+
+// stampedColumns are the columns every write sets to the time of the write.
+var stampedColumns = map[string]bool{"updated_at": true, "updated_at_ts": true}
+
+func (p *Postgres) Upsert(ctx context.Context, modems []*CableModem, versions Versions) ([]*CableModem, error) {
+	if err := uniqueMacs(modems); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var written []*CableModem
+	err := p.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkVersions(ctx, tx, versions); err != nil {
+			return err
+		}
+		for start := 0; start < len(modems); start += upsertBatch {
+			end := min(start+upsertBatch, len(modems))
+			w := &where{}
+			rows := make([]string, 0, end-start)
+			for _, m := range modems[start:end] {
+				rows = append(rows, "("+w.values(stamped(m, now).columnValues())+")")
+			}
+			query := upsertCableModems + strings.Join(rows, ", ") + onConflictUpdate + returningCableModems
+			batch, err := queryTx(ctx, tx, query, w.args...)
+			if err != nil {
+				return err
+			}
+			written = append(written, batch...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return byMac(written), nil
+}
+
+func (p *Postgres) MarkNotFound(ctx context.Context, macAddresses []string, date string, versions Versions) ([]*CableModem, error) {
+	return p.update(ctx, macAddresses, []string{"not_found_date"}, []interface{}{date}, versions)
+}
+
+func (p *Postgres) Restore(ctx context.Context, macAddresses []string, versions Versions) ([]*CableModem, error) {
+	return p.update(ctx, macAddresses, []string{"not_found_date"}, []interface{}{nil}, versions)
+}
+
+func (p *Postgres) Update(ctx context.Context, macAddresses []string, patch *CableModem, versions Versions) ([]*CableModem, error) {
+	columns, values := patch.patchColumns()
+	if len(columns) == 0 {
+		return nil, ErrEmptyPatch
+	}
+	return p.update(ctx, macAddresses, columns, values, versions)
+}
+
+// update sets columns to values, and the update times to now, on the given modems.
+func (p *Postgres) update(ctx context.Context, macAddresses []string, columns []string, values []interface{}, versions Versions) ([]*CableModem, error) {
+	if len(macAddresses) == 0 {
+		return nil, ErrEmptyValues
+	}
+	now := time.Now()
+	var updated []*CableModem
+	err := p.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkVersions(ctx, tx, versions); err != nil {
+			return err
+		}
+		w := &where{}
+		set := make([]string, 0, len(columns)+2)
+		for i, col := range columns {
+			set = append(set, col+" = "+w.arg(values[i]))
+		}
+		set = append(set, "updated_at = "+w.arg(now.UTC().Format(time.RFC3339)), "updated_at_ts = "+nextVersionSQL("updated_at_ts", w.arg(int32(now.Unix()))))
+		w.anyOf("mac", macAddresses)
+		var err error
+		updated, err = queryTx(ctx, tx, "UPDATE cablemodems SET "+strings.Join(set, ", ")+w.String()+returningCableModems, w.args...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return byMac(updated), nil
+}
+
+// uniqueMacs returns ErrDuplicateMac, naming the first repeated mac, if modems give one mac twice. A single
+// INSERT ... ON CONFLICT cannot update a row twice, so Postgres would fail the whole upsert anyway.
+func uniqueMacs(modems []*CableModem) error {
+	seen := make(map[string]bool, len(modems))
+	for _, m := range modems {
+		if seen[m.Mac] {
+			return fmt.Errorf("%w: %s", ErrDuplicateMac, m.Mac)
+		}
+		seen[m.Mac] = true
+	}
+	return nil
+}
+
+// checkVersions locks the modems of versions for the rest of tx and returns a *ConflictError if any of them
+// changed since it was read.
+func checkVersions(ctx context.Context, tx *sql.Tx, versions Versions) error {
+	if len(versions) == 0 {
+		return nil
+	}
+	macs := make([]string, 0, len(versions))
+	for mac := range versions {
+		macs = append(macs, mac)
+	}
+	sort.Strings(macs) // lock in a fixed order so that concurrent writers cannot deadlock.
+
+	w := &where{}
+	w.anyOf("mac", macs)
+	rows, err := tx.QueryContext(ctx, "SELECT mac, updated_at_ts FROM cablemodems"+w.String()+" ORDER BY mac FOR UPDATE", w.args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	current := make(map[string]*int32, len(macs))
+	for rows.Next() {
+		var (
+			mac string
+			ts  *int32
+		)
+		if err := rows.Scan(&mac, &ts); err != nil {
+			return err
+		}
+		current[mac] = ts
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return versions.conflicts(func(mac string) (*int32, bool) {
+		ts, ok := current[mac]
+		return ts, ok
+	})
+}
+
+// queryTx runs a statement returning cablemodems rows in tx and scans every row.
+func queryTx(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]*CableModem, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var modems []*CableModem
+	for rows.Next() {
+		m, err := scanCableModem(rows)
+		if err != nil {
+			return nil, err
+		}
+		modems = append(modems, m)
+	}
+	return modems, rows.Err()
+}
+
+// patchColumns returns the columns Update sets from patch, with their values.
+func (m *CableModem) patchColumns() (columns []string, values []interface{}) {
+	for i, v := range m.columnValues() {
+		col := cableModemColumns[i]
+		if v == nil || col == "mac" || stampedColumns[col] {
+			continue
+		}
+		columns = append(columns, col)
+		values = append(values, v)
+	}
+	return columns, values
+}
+
+// stamped returns m, or a copy of it stamped with now if it has no update time.
+func stamped(m *CableModem, now time.Time) *CableModem {
+	if m.UpdatedAtTs != nil {
+		return m
+	}
+	c := *m
+	c.touch(now)
+	return &c
+}
+
+// touch sets the update times of m to now, advancing its version even within the second of its last write.
+func (m *CableModem) touch(now time.Time) {
+	at, ts := now.UTC().Format(time.RFC3339), nextVersion(m.UpdatedAtTs, int32(now.Unix()))
+	m.UpdatedAt, m.UpdatedAtTs = &at, &ts
+}
+
+// nextVersion returns the updated_at_ts a write stamping ts leaves on a modem whose updated_at_ts was previous.
+// updated_at_ts is the version of conditional writes, so it must change on every write: two writes within one
+// second, or a write carrying an older stamp, get the previous version plus one instead.
+func nextVersion(previous *int32, ts int32) int32 {
+	if previous != nil && *previous >= ts {
+		return *previous + 1
+	}
+	return ts
+}
+
+// nextVersionSQL is nextVersion in SQL, for the stored version previous and the stamp ts.
+func nextVersionSQL(previous, ts string) string {
+	return "GREATEST(COALESCE(" + previous + ", 0) + 1, " + ts + ")"
+}
+
+// byMac orders modems by mac.
+func byMac(modems []*CableModem) []*CableModem {
+	if modems == nil {
+		modems = []*CableModem{}
+	}
+	sort.Slice(modems, func(i, j int) bool { return modems[i].Mac < modems[j].Mac })
+	return modems
+}
+
+var (
+	upsertCableModems = "INSERT INTO cablemodems (" + strings.Join(cableModemColumns, ", ") + ") VALUES "
+	onConflictUpdate  = func() string {
+		set := make([]string, 0, len(cableModemColumns)-1)
+		for _, col := range cableModemColumns[1:] {
+			if col == "updated_at_ts" {
+				set = append(set, col+" = "+nextVersionSQL("cablemodems.updated_at_ts", "EXCLUDED.updated_at_ts"))
+				continue
+			}
+			set = append(set, fmt.Sprintf("%[1]s = EXCLUDED.%[1]s", col))
+		}
+		return " ON CONFLICT (mac) DO UPDATE SET " + strings.Join(set, ", ")
+	}()
+	returningCableModems = " RETURNING " + strings.Join(cableModemColumns, ", ")
+)
+
+// values binds every value and returns their comma separated placeholders.
+func (w *where) values(values []interface{}) string {
+	placeholders := make([]string, len(values))
+	for i, v := range values {
+		placeholders[i] = w.arg(v)
+	}
+	return strings.Join(placeholders, ", ")
+}
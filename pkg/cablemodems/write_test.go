@@ -0,0 +1,216 @@
+package cablemodems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"api-project/pkg/sqltest"
+)
+
+func TestUpsertBatches(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	docsis := Docsis31
+	modems := make([]*CableModem, upsertBatch+1)
+	for i := range modems {
+		modems[i] = &CableModem{Mac: fmt.Sprintf("5c:22:da:00:%02x:%02x", i>>8, i&0xff), DocsisVersion: &docsis}
+	}
+	if _, err := NewPostgres(rec).Upsert(context.Background(), modems, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	statements := rec.Statements()
+	if len(statements) != 2 {
+		t.Fatalf("got %d statements, want 2", len(statements))
+	}
+	first := statements[0]
+	if !strings.HasPrefix(first.Query, "INSERT INTO cablemodems (mac, cpe_mac,") ||
+		!strings.Contains(first.Query, "ON CONFLICT (mac) DO UPDATE SET cpe_mac = EXCLUDED.cpe_mac,") ||
+		!strings.Contains(first.Query, "updated_at_ts = GREATEST(COALESCE(cablemodems.updated_at_ts, 0) + 1, EXCLUDED.updated_at_ts),") {
+		t.Errorf("got %q", first.Query[:200])
+	}
+	if got, want := len(first.Args), upsertBatch*len(cableModemColumns); got != want {
+		t.Errorf("first statement binds %d values, want %d", got, want)
+	}
+	if got := len(statements[1].Args); got != len(cableModemColumns) {
+		t.Errorf("second statement binds %d values, want one modem", got)
+	}
+	if first.Args[0] != "5c:22:da:00:00:00" || first.Args[1] != nil || first.Args[11] != "Docsis31" {
+		t.Errorf("got values %v", first.Args[:12])
+	}
+}
+
+func TestUpdateStatements(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cmtsType, deviceType := "vcmts", int32(2)
+	for _, tt := range []struct {
+		name   string
+		call   func(p *Postgres) error
+		prefix string
+		values []interface{}
+	}{
+		{"MarkNotFound", func(p *Postgres) error {
+			_, err := p.MarkNotFound(ctx, []string{"5c:22:da:0e:9f:01"}, "20261018", nil)
+			return err
+		}, "UPDATE cablemodems SET not_found_date = $1, updated_at = $2, updated_at_ts = GREATEST(COALESCE(updated_at_ts, 0) + 1, $3) WHERE mac = ANY($4) RETURNING mac,",
+			[]interface{}{"20261018"}},
+		{"Restore", func(p *Postgres) error {
+			_, err := p.Restore(ctx, []string{"5c:22:da:0e:9f:01"}, nil)
+			return err
+		}, "UPDATE cablemodems SET not_found_date = $1, updated_at = $2, updated_at_ts = GREATEST(COALESCE(updated_at_ts, 0) + 1, $3) WHERE mac = ANY($4) RETURNING mac,",
+			[]interface{}{nil}},
+		{"Update", func(p *Postgres) error {
+			_, err := p.Update(ctx, []string{"5c:22:da:0e:9f:01"}, &CableModem{Mac: "ignored", CmtsType: &cmtsType, DeviceType: &deviceType}, nil)
+			return err
+		}, "UPDATE cablemodems SET cmts_type = $1, device_type = $2, updated_at = $3, updated_at_ts = GREATEST(COALESCE(updated_at_ts, 0) + 1, $4) WHERE mac = ANY($5) RETURNING mac,",
+			[]interface{}{"vcmts", int64(2)}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := sqltest.New(t)
+			if err := tt.call(NewPostgres(rec)); err != nil {
+				t.Fatal(err)
+			}
+			statements := rec.Statements()
+			if len(statements) != 1 || !strings.HasPrefix(statements[0].Query, tt.prefix) {
+				t.Fatalf("got %+v", statements)
+			}
+			args := statements[0].Args
+			if !reflect.DeepEqual(args[:len(tt.values)], tt.values) || args[len(args)-1] != `{"5c:22:da:0e:9f:01"}` {
+				t.Errorf("got values %v", args)
+			}
+		})
+	}
+}
+
+func TestUpdateRejectsEmptyPatch(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	updatedAt := "2026-10-18T00:00:00Z"
+	_, err := NewPostgres(rec).Update(context.Background(), []string{"5c:22:da:0e:9f:01"}, &CableModem{Mac: "5c:22:da:0e:9f:01", UpdatedAt: &updatedAt}, nil)
+	if !errors.Is(err, ErrEmptyPatch) {
+		t.Fatalf("got %v, want ErrEmptyPatch", err)
+	}
+	if n := len(rec.Statements()); n != 0 {
+		t.Errorf("%d statements reached the database", n)
+	}
+}
+
+func TestVersionsLockBeforeWriting(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	_, err := NewPostgres(rec).MarkNotFound(context.Background(), []string{"5c:22:da:0e:9f:01"}, "20261018", Versions{"5c:22:da:0e:9f:01": 1760000000})
+	var conflict *ConflictError
+	// the recorder has no rows, so the modem looks vanished.
+	if !errors.As(err, &conflict) || !reflect.DeepEqual(conflict.Conflicts, []string{"5c:22:da:0e:9f:01"}) {
+		t.Fatalf("got %v, want a conflict on 5c:22:da:0e:9f:01", err)
+	}
+	statements := rec.Statements()
+	if len(statements) != 1 || statements[0].Query != "SELECT mac, updated_at_ts FROM cablemodems WHERE mac = ANY($1) ORDER BY mac FOR UPDATE" {
+		t.Errorf("got %+v, want only the locking read", statements)
+	}
+}
+
+func TestMemoryVersions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mem := loadMemory(t)
+	cmtsType := "vcmts"
+	patch := &CableModem{CmtsType: &cmtsType}
+
+	updated, err := mem.Update(ctx, []string{"5c:22:da:0e:9f:01"}, patch, Versions{"5c:22:da:0e:9f:01": 1760000000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = mem.Update(ctx, []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}, patch, Versions{"5c:22:da:0e:9f:01": 1760000000, "5c:22:da:0e:9f:02": 1760000000})
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) || !reflect.DeepEqual(conflict.Conflicts, []string{"5c:22:da:0e:9f:01"}) {
+		t.Fatalf("got %v, want a conflict on the modem written since", err)
+	}
+	if got, _ := mem.ByMac(ctx, []string{"5c:22:da:0e:9f:02"}); got[0].CmtsType != nil {
+		t.Error("a conflicting write was partly applied")
+	}
+	if _, err := mem.Restore(ctx, []string{"5c:22:da:0e:9f:01"}, Versions{"5c:22:da:0e:9f:01": *updated[0].UpdatedAtTs}); err != nil {
+		t.Errorf("write at the current version: %v", err)
+	}
+	if _, err := mem.Upsert(ctx, []*CableModem{{Mac: "5c:22:da:0e:9f:ff"}}, Versions{"5c:22:da:0e:9f:ff": 1}); !errors.As(err, &conflict) {
+		t.Errorf("versioned upsert of a missing modem: got %v", err)
+	}
+}
+
+func TestVersionsAdvanceWithinASecond(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mem := loadMemory(t)
+	cmtsType, deviceType := "vcmts", int32(2)
+	mac := "5c:22:da:0e:9f:01"
+
+	// the writes below all land within the same second, or before the fixture's stamp.
+	first, err := mem.Update(ctx, []string{mac}, &CableModem{CmtsType: &cmtsType}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := *first[0].UpdatedAtTs
+	second, err := mem.Update(ctx, []string{mac}, &CableModem{DeviceType: &deviceType}, Versions{mac: read})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *second[0].UpdatedAtTs <= read {
+		t.Fatalf("second write left version %d, first %d", *second[0].UpdatedAtTs, read)
+	}
+	var conflict *ConflictError
+	if _, err := mem.Restore(ctx, []string{mac}, Versions{mac: read}); !errors.As(err, &conflict) {
+		t.Errorf("write at the version before the second write: got %v, want a conflict", err)
+	}
+
+	old := int32(1)
+	upserted, err := mem.Upsert(ctx, []*CableModem{{Mac: mac, UpdatedAtTs: &old}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *upserted[0].UpdatedAtTs != *second[0].UpdatedAtTs+1 {
+		t.Errorf("upsert of an older stamp left version %d after %d", *upserted[0].UpdatedAtTs, *second[0].UpdatedAtTs)
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	t.Parallel()
+	previous := int32(1760000000)
+	for _, tt := range []struct {
+		previous *int32
+		ts, want int32
+	}{
+		{nil, 1760000000, 1760000000},
+		{&previous, 1760000001, 1760000001},
+		{&previous, 1760000000, 1760000001},
+		{&previous, 1, 1760000001},
+	} {
+		if got := nextVersion(tt.previous, tt.ts); got != tt.want {
+			t.Errorf("nextVersion(%v, %d) = %d, want %d", tt.previous, tt.ts, got, tt.want)
+		}
+	}
+}
+
+func TestUpsertRejectsDuplicateMacs(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	mem := loadMemory(t)
+	fqdn := "acr02.den.example.net"
+	modems := []*CableModem{{Mac: "5c:22:da:0e:9f:01", Fqdn: &fqdn}, {Mac: "5c:22:da:0e:9f:ff"}, {Mac: "5c:22:da:0e:9f:01"}}
+
+	for name, w := range map[string]Writer{"Postgres": NewPostgres(rec), "Memory": mem} {
+		if _, err := w.Upsert(context.Background(), modems, nil); !errors.Is(err, ErrDuplicateMac) || !strings.Contains(err.Error(), "5c:22:da:0e:9f:01") {
+			t.Errorf("%s: got %v, want ErrDuplicateMac naming 5c:22:da:0e:9f:01", name, err)
+		}
+	}
+	if n := len(rec.Statements()); n != 0 {
+		t.Errorf("%d statements reached the database", n)
+	}
+	if got, _ := mem.ByMac(context.Background(), []string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:ff"}); len(got) != 1 || got[0].Fqdn == nil || *got[0].Fqdn == fqdn {
+		t.Errorf("the rejected batch was partly written: %+v", got)
+	}
+}
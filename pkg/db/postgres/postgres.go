@@ -2,9 +2,8 @@ package postgres
 
 import (
 	"api-project/pkg/envvar"
+	"context"
 	"database/sql"
-	"errors"
-	"fmt"
 
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog/log"
@@ -14,26 +13,16 @@ var (
 	isDebug = envvar.GetBool("DEBUG", false)
 )
 
-func (rdsPgs *Rds_Postgres) CreateDbConn() (*sql.DB, error) {
-	if isDebug {
-		return rdsPgs.newDefaultClient()
-	}
-	return nil, errors.New("pending for RDS configuration in cloud")
+func (rdsPgs *Rds_Postgres) CreateDbConn(ctx context.Context) (*sql.DB, error) {
+	return Open(ctx, rdsPgs.Config)
 }
 
-func (rdsPgs *Rds_Postgres) newDefaultClient() (*sql.DB, error) {
-	var (
-		username = "postgres"
-		password = ""
-		host     = "localhost"
-		dbPort   = 5432
-	)
-
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s",
-		host, dbPort, username, password, username,
-	)
-	if isDebug {
-		dsn += " sslmode=disable"
+// Open opens a pool sized by cfg and checks that the database answers, giving up when ctx is done.
+func Open(ctx context.Context, cfg Config) (*sql.DB, error) {
+	dsn, err := cfg.DSN()
+	if err != nil {
+		log.Error().Caller().Err(err).Msg("invalid db configuration")
+		return nil, err
 	}
 
 	db, err := sql.Open("postgres", dsn)
@@ -41,9 +30,14 @@ func (rdsPgs *Rds_Postgres) newDefaultClient() (*sql.DB, error) {
 		log.Error().Caller().Err(err).Msg("db open connection failed")
 		return nil, err
 	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
 
-	err = db.Ping()
+	err = db.PingContext(ctx)
 	if err != nil {
+		db.Close()
 		log.Error().Caller().Err(err).Msg("ping db failed")
 		return nil, err
 	}
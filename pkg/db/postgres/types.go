@@ -1,3 +1,29 @@
 package postgres
 
-type Rds_Postgres struct{}
+import "time"
+
+type Rds_Postgres struct {
+	Config Config
+}
+
+// Config is everything needed to open and size a connection pool.
+// When URL is set it replaces the individual connection fields.
+type Config struct {
+	URL string
+
+	Host        string
+	Port        int
+	User        string
+	Password    string
+	DBName      string
+	SSLMode     string
+	SSLRootCert string
+
+	// StatementTimeout aborts any statement running longer than this. 0 leaves the server default.
+	StatementTimeout time.Duration
+
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
@@ -2,25 +2,126 @@ package dbservice
 
 import (
 	"api-project/pkg/db/postgres"
+	"api-project/pkg/envvar"
+	"context"
 	"database/sql"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
-var (
-	rds       = &postgres.Rds_Postgres{}
-	DbService = &DataBaseService{
-		db: rds,
-	}
+var errNotConnected = errors.New("db primary not connected yet")
+
+const (
+	// minRetry is the floor of Options.RetryMin: the backoff doubles from it, so a zero would retry the primary
+	// in a busy loop.
+	minRetry = 10 * time.Millisecond
+	// checkTimeout bounds one attempt to connect to or ping a database.
+	checkTimeout = 5 * time.Second
 )
 
-func init() {
-	db, err := DbService.FetchDbConn()
-	if err != nil {
-		panic("postgresDB connection initialization with error failed")
+// OptionsFromEnv configures the primary and replicas from the environment (see postgres.ConfigFromEnv and
+// postgres.ReplicaConfigsFromEnv) along with:
+//
+//	DB_STARTUP_TIMEOUT            how long to wait for the primary at startup, default 10s
+//	DB_RETRY_MIN, DB_RETRY_MAX    backoff between connection attempts, default 500ms doubling up to 30s
+//	DB_READ_YOUR_WRITES_WINDOW    default 5s
+//	DB_REPLICA_HEALTH_INTERVAL    default 10s
+func OptionsFromEnv() Options {
+	primary := postgres.ConfigFromEnv()
+	opts := Options{
+		Primary:               &postgres.Rds_Postgres{Config: primary},
+		StartupTimeout:        envvar.GetDuration("DB_STARTUP_TIMEOUT", 10*time.Second),
+		RetryMin:              envvar.GetDuration("DB_RETRY_MIN", 500*time.Millisecond),
+		RetryMax:              envvar.GetDuration("DB_RETRY_MAX", 30*time.Second),
+		ReadYourWrites:        envvar.GetDuration("DB_READ_YOUR_WRITES_WINDOW", 5*time.Second),
+		ReplicaHealthInterval: envvar.GetDuration("DB_REPLICA_HEALTH_INTERVAL", 10*time.Second),
+	}
+	for _, cfg := range postgres.ReplicaConfigsFromEnv(primary) {
+		opts.Replicas = append(opts.Replicas, Replica{Name: cfg.Name(), Conn: &postgres.Rds_Postgres{Config: cfg}})
+	}
+	return opts
+}
+
+// New starts connecting to the databases in opts and waits up to opts.StartupTimeout for the primary.
+// It never fails: if the primary is still unreachable the service starts not ready and keeps retrying in the
+// background until ctx is done.
+func New(ctx context.Context, opts Options) *DataBaseService {
+	opts.RetryMin = max(opts.RetryMin, minRetry)
+	opts.RetryMax = max(opts.RetryMax, opts.RetryMin)
+	dbs := &DataBaseService{opts: opts, ready: make(chan struct{})}
+	for _, r := range opts.Replicas {
+		dbs.replicas = append(dbs.replicas, &replica{name: r.Name, conn: r.Conn})
+	}
+
+	go dbs.connect(ctx)
+	go dbs.watchReplicas(ctx)
+
+	startup, cancel := context.WithTimeout(ctx, opts.StartupTimeout)
+	defer cancel()
+	if err := dbs.WaitReady(startup); err != nil {
+		log.Warn().Dur("startup_timeout", opts.StartupTimeout).Msg("db primary unreachable: starting not ready")
 	}
-	DbService.DbReader = db
-	DbService.DbWriter = db
+	return dbs
 }
 
-func (dbs *DataBaseService) FetchDbConn() (*sql.DB, error) {
-	return dbs.db.CreateDbConn()
+// Ready reports whether the primary has been reached.
+func (dbs *DataBaseService) Ready() bool {
+	select {
+	case <-dbs.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// Ping checks that the primary answers right now, where Ready only reports that it was reached once. It fails
+// while the service is not ready.
+func (dbs *DataBaseService) Ping(ctx context.Context) error {
+	db := dbs.primary.Load()
+	if db == nil {
+		return errNotConnected
+	}
+	return db.PingContext(ctx)
+}
+
+// WaitReady blocks until the service is ready or ctx is done.
+func (dbs *DataBaseService) WaitReady(ctx context.Context) error {
+	select {
+	case <-dbs.ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (dbs *DataBaseService) FetchDbConn(ctx context.Context) (*sql.DB, error) {
+	return dbs.opts.Primary.CreateDbConn(ctx)
+}
+
+// connect retries the primary with jittered exponential backoff until it answers or ctx is done.
+func (dbs *DataBaseService) connect(ctx context.Context) {
+	delay := dbs.opts.RetryMin
+	for attempt := 1; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		db, err := dbs.FetchDbConn(attemptCtx)
+		cancel()
+		if err == nil {
+			dbs.primary.Store(db)
+			close(dbs.ready)
+			log.Info().Int("attempt", attempt).Msg("db primary connected")
+			return
+		}
+
+		wait := delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+		log.Warn().Err(err).Int("attempt", attempt).Dur("retry_in", wait).Msg("db primary connection failed")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		delay = min(delay*2, dbs.opts.RetryMax)
+	}
 }
@@ -0,0 +1,192 @@
+package dbservice
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func TestMain(m *testing.M) {
+	log.Logger = zerolog.New(io.Discard)
+	os.Exit(m.Run())
+}
+
+// nopDriver opens connections that answer pings and nothing else.
+type nopDriver struct{}
+type nopConn struct{}
+
+func (nopDriver) Open(string) (driver.Conn, error)  { return nopConn{}, nil }
+func (nopConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (nopConn) Close() error                        { return nil }
+func (nopConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func init() { sql.Register("nop", nopDriver{}) }
+
+// fakeConn fails its first `failures` connection attempts and then hands out a pool of nopConns.
+type fakeConn struct {
+	failures atomic.Int32
+	calls    atomic.Int32
+	db       *sql.DB
+}
+
+func newFakeConn(t *testing.T, failures int32) *fakeConn {
+	db, err := sql.Open("nop", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	f := &fakeConn{db: db}
+	f.failures.Store(failures)
+	return f
+}
+
+func (f *fakeConn) CreateDbConn(ctx context.Context) (*sql.DB, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, errors.New("connecting without a deadline")
+	}
+	if f.calls.Add(1) <= f.failures.Load() {
+		return nil, errors.New("connection refused")
+	}
+	return f.db, nil
+}
+
+func testOptions(primary DbServiceInterface) Options {
+	return Options{
+		Primary:               primary,
+		StartupTimeout:        time.Second,
+		RetryMin:              time.Millisecond,
+		RetryMax:              4 * time.Millisecond,
+		ReplicaHealthInterval: time.Hour,
+	}
+}
+
+func TestNewClampsRetry(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		min, max         time.Duration
+		wantMin, wantMax time.Duration
+	}{
+		{time.Millisecond, 4 * time.Millisecond, minRetry, minRetry},
+		{0, 0, minRetry, minRetry},
+		{-time.Second, time.Second, minRetry, time.Second},
+		{2 * time.Second, time.Second, 2 * time.Second, 2 * time.Second},
+	} {
+		opts := testOptions(newFakeConn(t, 0))
+		opts.RetryMin, opts.RetryMax = tt.min, tt.max
+		dbs := New(t.Context(), opts)
+		if got := dbs.opts; got.RetryMin != tt.wantMin || got.RetryMax != tt.wantMax {
+			t.Errorf("%v..%v: got %v..%v, want %v..%v", tt.min, tt.max, got.RetryMin, got.RetryMax, tt.wantMin, tt.wantMax)
+		}
+	}
+}
+
+func TestNewRetriesPrimary(t *testing.T) {
+	t.Parallel()
+	primary := newFakeConn(t, 3)
+	dbs := New(t.Context(), testOptions(primary))
+	if !dbs.Ready() {
+		t.Fatal("expected the service to be ready after retrying")
+	}
+	if got := primary.calls.Load(); got != 4 {
+		t.Errorf("got %d connection attempts, want 4", got)
+	}
+	if dbs.Reader(t.Context()) != primary.db || dbs.Writer(t.Context()) != primary.db {
+		t.Error("expected reads and writes on the primary without replicas")
+	}
+}
+
+func TestNewStartsNotReady(t *testing.T) {
+	t.Parallel()
+	primary := newFakeConn(t, 1<<30)
+	opts := testOptions(primary)
+	opts.StartupTimeout = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	dbs := New(ctx, opts)
+	if dbs.Ready() {
+		t.Fatal("expected the service to start not ready")
+	}
+	session := WithSession(ctx, time.Time{})
+	if dbs.Reader(session) != nil || dbs.Writer(session) != nil {
+		t.Error("expected no pool while not ready")
+	}
+	if last := LastWrite(session); !last.IsZero() {
+		t.Errorf("a write that found no primary opened the read-your-writes window at %v", last)
+	}
+
+	if err := dbs.Ping(ctx); err == nil {
+		t.Error("expected Ping to fail while not ready")
+	}
+
+	// the primary comes up: the background retries pick it up.
+	primary.failures.Store(0)
+	wait, cancelWait := context.WithTimeout(ctx, time.Second)
+	defer cancelWait()
+	if err := dbs.WaitReady(wait); err != nil {
+		t.Fatalf("expected the service to recover: %v", err)
+	}
+	if err := dbs.Ping(ctx); err != nil {
+		t.Errorf("Ping after recovering: %v", err)
+	}
+}
+
+func TestReaderRouting(t *testing.T) {
+	t.Parallel()
+	primary := newFakeConn(t, 0)
+	r1, r2 := newFakeConn(t, 0), newFakeConn(t, 0)
+	opts := testOptions(primary)
+	opts.Replicas = []Replica{{Name: "r1", Conn: r1}, {Name: "r2", Conn: r2}}
+	opts.ReadYourWrites = 50 * time.Millisecond
+	dbs := New(t.Context(), opts)
+	// wait for the watcher's first health check; the next one is an hour away.
+	for !dbs.replicas[0].healthy.Load() || !dbs.replicas[1].healthy.Load() {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx := WithSession(t.Context(), time.Time{})
+	seen := map[*sql.DB]int{}
+	for i := 0; i < 10; i++ {
+		seen[dbs.Reader(ctx)]++
+	}
+	if seen[r1.db] != 5 || seen[r2.db] != 5 {
+		t.Errorf("expected round-robin across replicas, got %v", seen)
+	}
+
+	dbs.replicas[0].healthy.Store(false)
+	for i := 0; i < 4; i++ {
+		if got := dbs.Reader(ctx); got != r2.db {
+			t.Fatal("expected reads to skip the ejected replica")
+		}
+	}
+
+	dbs.Writer(ctx)
+	if dbs.Reader(ctx) != primary.db {
+		t.Error("expected reads on the primary right after a write")
+	}
+	// other callers, and the writer's own calls that bring back its last write, are routed on their own.
+	if dbs.Reader(t.Context()) != r2.db || dbs.Reader(WithSession(t.Context(), time.Time{})) != r2.db {
+		t.Error("expected another caller's reads on the replicas")
+	}
+	if dbs.Reader(WithSession(t.Context(), LastWrite(ctx))) != primary.db {
+		t.Error("expected reads on the primary in a later call of the writer")
+	}
+	time.Sleep(opts.ReadYourWrites)
+	if dbs.Reader(ctx) != r2.db {
+		t.Error("expected reads back on the replicas after the read-your-writes window")
+	}
+
+	dbs.replicas[1].healthy.Store(false)
+	if dbs.Reader(ctx) != primary.db {
+		t.Error("expected reads on the primary with no healthy replica")
+	}
+}
@@ -0,0 +1,125 @@
+package dbservice
+
+import (
+	"context"
+	"database/sql"
+	"expvar"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// routes counts read routing decisions by "target/reason"; replicaHealth is 1 for every replica in the rotation.
+// Both are served on /debug/vars.
+var (
+	routes        = expvar.NewMap("dbservice_routes")
+	replicaHealth = expvar.NewMap("dbservice_replica_healthy")
+)
+
+const (
+	reasonRoundRobin     = "round_robin"
+	reasonReadYourWrites = "read_your_writes"
+	reasonNoReplica      = "no_healthy_replica"
+	reasonWrite          = "write"
+)
+
+// Reader returns the pool the next read of ctx should run against, or nil while the service is not ready.
+func (dbs *DataBaseService) Reader(ctx context.Context) *sql.DB {
+	if last := LastWrite(ctx); !last.IsZero() && time.Since(last) < dbs.opts.ReadYourWrites {
+		return dbs.route("primary", reasonReadYourWrites, dbs.primary.Load())
+	}
+	n := len(dbs.replicas)
+	start := dbs.next.Add(1)
+	for i := 0; i < n; i++ {
+		r := dbs.replicas[(start+uint64(i))%uint64(n)]
+		if db := r.pool(); db != nil && r.healthy.Load() {
+			return dbs.route(r.name, reasonRoundRobin, db)
+		}
+	}
+	return dbs.route("primary", reasonNoReplica, dbs.primary.Load())
+}
+
+// Writer returns the primary and opens the read-your-writes window of the session of ctx, if it carries one,
+// so only ask for it to write. It returns nil while the service is not ready, leaving the window shut.
+func (dbs *DataBaseService) Writer(ctx context.Context) *sql.DB {
+	db := dbs.primary.Load()
+	if s := sessionOf(ctx); s != nil && db != nil {
+		s.wrote()
+	}
+	return dbs.route("primary", reasonWrite, db)
+}
+
+func (dbs *DataBaseService) route(target, reason string, db *sql.DB) *sql.DB {
+	routes.Add(target+"/"+reason, 1)
+	log.Debug().Str("target", target).Str("reason", reason).Msg("db route")
+	return db
+}
+
+func (r *replica) pool() *sql.DB {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.db
+}
+
+// check connects to the replica if it is not connected yet, pings it, and moves it in or out of the rotation.
+func (r *replica) check(ctx context.Context) {
+	db := r.pool()
+	var err error
+	if db == nil {
+		if db, err = r.conn.CreateDbConn(ctx); err == nil {
+			r.mu.Lock()
+			r.db = db
+			r.mu.Unlock()
+		}
+	} else {
+		err = db.PingContext(ctx)
+	}
+
+	healthy := err == nil
+	if was := r.healthy.Swap(healthy); was != healthy {
+		if healthy {
+			log.Info().Str("replica", r.name).Msg("db replica back in rotation")
+		} else {
+			log.Warn().Err(err).Str("replica", r.name).Msg("db replica ejected from rotation")
+		}
+	}
+	if healthy {
+		replicaHealth.Set(r.name, oneVar)
+	} else {
+		replicaHealth.Set(r.name, zeroVar)
+	}
+}
+
+var oneVar, zeroVar = intVar(1), intVar(0)
+
+func intVar(n int64) *expvar.Int {
+	v := new(expvar.Int)
+	v.Set(n)
+	return v
+}
+
+func (dbs *DataBaseService) checkReplicas(ctx context.Context) {
+	for _, r := range dbs.replicas {
+		ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+		r.check(ctx)
+		cancel()
+	}
+}
+
+// watchReplicas checks every replica right away and then every ReplicaHealthInterval until ctx is done.
+func (dbs *DataBaseService) watchReplicas(ctx context.Context) {
+	if len(dbs.replicas) == 0 {
+		return
+	}
+	dbs.checkReplicas(ctx)
+	t := time.NewTicker(dbs.opts.ReplicaHealthInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			dbs.checkReplicas(ctx)
+		}
+	}
+}
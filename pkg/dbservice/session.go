@@ -0,0 +1,130 @@
+package dbservice
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// LastWriteHeader carries a caller's read-your-writes session between requests, as the unix nanoseconds of its
+// last write. A response to a request that wrote sets it; a caller that sends it back reads from the primary
+// until the read-your-writes window after that write has passed. Callers that never send it back read from the
+// replicas right away, except within the request that wrote.
+const LastWriteHeader = "X-Last-Write"
+
+// session is the read-your-writes state of one caller.
+type session struct {
+	lastWrite atomic.Int64 // unix nanoseconds, 0 before the first write
+}
+
+type sessionKey struct{}
+
+// WithSession returns ctx carrying a read-your-writes session whose last write was at lastWrite, the zero Time
+// for none. Writer records the writes made with the returned context on the session and Reader routes its reads.
+// A lastWrite in the future counts as now, so a caller cannot pin its reads to the primary beyond one window.
+func WithSession(ctx context.Context, lastWrite time.Time) context.Context {
+	s := &session{}
+	if !lastWrite.IsZero() {
+		s.lastWrite.Store(min(lastWrite.UnixNano(), time.Now().UnixNano()))
+	}
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+// LastWrite returns when the session of ctx last wrote, or the zero Time if it never did or ctx carries none.
+func LastWrite(ctx context.Context) time.Time {
+	s := sessionOf(ctx)
+	if s == nil {
+		return time.Time{}
+	}
+	if ns := s.lastWrite.Load(); ns != 0 {
+		return time.Unix(0, ns)
+	}
+	return time.Time{}
+}
+
+// ParseLastWrite parses a LastWriteHeader value, returning the zero Time for a missing or malformed one.
+func ParseLastWrite(v string) time.Time {
+	ns, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || ns <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+// FormatLastWrite formats t as a LastWriteHeader value.
+func FormatLastWrite(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func (s *session) wrote() {
+	s.lastWrite.Store(time.Now().UnixNano())
+}
+
+func sessionOf(ctx context.Context) *session {
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	return s
+}
+
+// SessionHandler gives every request a read-your-writes session seeded from its LastWriteHeader, and sets the
+// header on the response once the session has written, unless next set it already.
+func SessionHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithSession(r.Context(), ParseLastWrite(r.Header.Get(LastWriteHeader)))
+		next.ServeHTTP(&sessionWriter{ResponseWriter: w, ctx: ctx}, r.WithContext(ctx))
+	})
+}
+
+// sessionWriter sets LastWriteHeader right before the response header goes out.
+type sessionWriter struct {
+	http.ResponseWriter
+	ctx     context.Context
+	stamped bool
+}
+
+func (w *sessionWriter) stamp() {
+	if w.stamped {
+		return
+	}
+	w.stamped = true
+	if t := LastWrite(w.ctx); !t.IsZero() && w.Header().Get(LastWriteHeader) == "" {
+		w.Header().Set(LastWriteHeader, FormatLastWrite(t))
+	}
+}
+
+func (w *sessionWriter) WriteHeader(code int) {
+	w.stamp()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *sessionWriter) Write(b []byte) (int, error) {
+	w.stamp()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *sessionWriter) Flush() {
+	w.stamp()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack hands the connection over, for a websocket upgrade for instance. Gin asserts that the writer it wraps
+// can, so sessionWriter must too.
+func (w *sessionWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// CloseNotify is, like Hijack, asserted by gin without checking. A writer that cannot notify never does.
+func (w *sessionWriter) CloseNotify() <-chan bool {
+	if cn, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *sessionWriter) Unwrap() http.ResponseWriter { return w.ResponseWriter }
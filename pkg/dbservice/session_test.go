@@ -0,0 +1,103 @@
+package dbservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseLastWrite(t *testing.T) {
+	t.Parallel()
+	at := time.Unix(1760000000, 123)
+	for _, tt := range []struct {
+		v    string
+		want time.Time
+	}{
+		{FormatLastWrite(at), at},
+		{"", time.Time{}},
+		{"0", time.Time{}},
+		{"-5", time.Time{}},
+		{"yesterday", time.Time{}},
+	} {
+		if got := ParseLastWrite(tt.v); !got.Equal(tt.want) {
+			t.Errorf("ParseLastWrite(%q) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestWithSession(t *testing.T) {
+	t.Parallel()
+	if got := LastWrite(context.Background()); !got.IsZero() {
+		t.Errorf("without a session: got %v", got)
+	}
+	if got := LastWrite(WithSession(context.Background(), time.Time{})); !got.IsZero() {
+		t.Errorf("new session: got %v", got)
+	}
+	// a last write in the future is capped, so that it cannot hold reads on the primary for longer than a window.
+	if got := LastWrite(WithSession(context.Background(), time.Now().Add(time.Hour))); got.After(time.Now()) {
+		t.Errorf("future last write: got %v", got)
+	}
+}
+
+func TestSessionHandler(t *testing.T) {
+	t.Parallel()
+	dbs := &DataBaseService{}
+	dbs.primary.Store(newFakeConn(t, 0).db)
+	h := SessionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			dbs.Writer(r.Context())
+		}
+		w.Write([]byte("ok"))
+	}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if got := w.Header().Get(LastWriteHeader); got != "" {
+		t.Errorf("read: got %s %q", LastWriteHeader, got)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	written := ParseLastWrite(w.Header().Get(LastWriteHeader))
+	if written.IsZero() {
+		t.Fatalf("write: got %s %q", LastWriteHeader, w.Header().Get(LastWriteHeader))
+	}
+
+	// the caller brings its session along to its next request.
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set(LastWriteHeader, FormatLastWrite(written))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if got := ParseLastWrite(w.Header().Get(LastWriteHeader)); !got.Equal(written) {
+		t.Errorf("read after the write: got %v, want %v", got, written)
+	}
+}
+
+// TestSessionHandlerHijack upgrades the connection through the session writer, as gin does for a websocket: gin
+// asserts http.Hijacker and http.CloseNotifier on the writer it wraps without checking.
+func TestSessionHandlerHijack(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(SessionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = w.(http.CloseNotifier).CloseNotify()
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 204 No Content\r\nConnection: close\r\n\r\n")
+		buf.Flush()
+	})))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNoContent {
+		t.Errorf("got %d", res.StatusCode)
+	}
+}
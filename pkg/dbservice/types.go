@@ -1,13 +1,60 @@
 package dbservice
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+)
 
 type DbServiceInterface interface {
-	CreateDbConn() (*sql.DB, error)
+	CreateDbConn(ctx context.Context) (*sql.DB, error)
 }
 
+// Options configures New.
+type Options struct {
+	Primary  DbServiceInterface
+	Replicas []Replica
+
+	// StartupTimeout is how long New waits for the primary before returning a service that is not ready yet.
+	StartupTimeout time.Duration
+	// RetryMin and RetryMax bound the exponential backoff between attempts to connect to the primary.
+	// New raises RetryMin to at least 10ms and RetryMax to at least RetryMin.
+	RetryMin, RetryMax time.Duration
+
+	ReadYourWrites        time.Duration
+	ReplicaHealthInterval time.Duration
+}
+
+// Replica names the connection to one read replica.
+type Replica struct {
+	Name string
+	Conn DbServiceInterface
+}
+
+// DataBaseService routes statements between the primary and its read replicas.
+// Writes always go to the primary. Reads go round-robin to the healthy replicas, except when no replica is
+// healthy, or within the read-your-writes window after a write of the same session (see WithSession), when they
+// go to the primary too.
+//
+// Until the primary has been reached the service is not ready: Reader and Writer return nil.
 type DataBaseService struct {
-	db       DbServiceInterface
-	DbReader *sql.DB
-	DbWriter *sql.DB
+	opts     Options
+	primary  atomic.Pointer[sql.DB]
+	ready    chan struct{} // closed once primary is set
+	replicas []*replica
+
+	next atomic.Uint64
+}
+
+// replica is one read replica. A replica that fails its health check is ejected from the rotation until a
+// later check succeeds.
+type replica struct {
+	name    string
+	conn    DbServiceInterface
+	healthy atomic.Bool
+
+	mu sync.RWMutex
+	db *sql.DB // nil until the first successful connection
 }
@@ -0,0 +1,100 @@
+// Package mac parses and normalizes cable modem MAC addresses. Clients send them in every common notation;
+// the database holds only the canonical one, so every API parses its input through this package before it
+// reaches a query.
+package mac
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Address is a 48-bit MAC address.
+type Address [6]byte
+
+// Parse accepts the colon (5C:22:DA:0E:9F:AB), dash (5c-22-da-0e-9f-ab), Cisco dotted (5c22.da0e.9fab) and bare
+// hex (5c22da0e9fab) notations, in any case, with surrounding whitespace ignored.
+func Parse(s string) (Address, error) {
+	var a Address
+	hexDigits, ok := digits(strings.TrimSpace(s))
+	if !ok || len(hexDigits) != 12 {
+		return a, fmt.Errorf("invalid mac address %q", s)
+	}
+	if _, err := hex.Decode(a[:], []byte(hexDigits)); err != nil {
+		return a, fmt.Errorf("invalid mac address %q", s)
+	}
+	return a, nil
+}
+
+// digits strips the separators of a supported notation, returning false if s mixes or misplaces them.
+func digits(s string) (string, bool) {
+	switch {
+	case len(s) == 17 && (s[2] == ':' || s[2] == '-'):
+		sep := s[2]
+		for i := 2; i < 17; i += 3 {
+			if s[i] != sep {
+				return "", false
+			}
+		}
+		return strings.ReplaceAll(s, string(sep), ""), true
+	case len(s) == 14 && s[4] == '.' && s[9] == '.':
+		return s[0:4] + s[5:9] + s[10:14], true
+	default:
+		return s, true
+	}
+}
+
+// String returns the canonical form stored in the database: lower case hex octets separated by colons, as in
+// 5c:22:da:0e:9f:ab.
+func (a Address) String() string {
+	return octets(a[:])
+}
+
+// OUI returns the organizationally unique identifier, the vendor prefix of the address, in the notation of
+// String: 5c:22:da.
+func (a Address) OUI() string {
+	return octets(a[:3])
+}
+
+func octets(b []byte) string {
+	s := make([]byte, 0, 3*len(b)-1)
+	for i, o := range b {
+		if i > 0 {
+			s = append(s, ':')
+		}
+		s = hex.AppendEncode(s, []byte{o})
+	}
+	return string(s)
+}
+
+// InvalidError lists the entries of a request that are not MAC addresses.
+type InvalidError struct {
+	Invalid []string
+}
+
+func (e *InvalidError) Error() string {
+	quoted := make([]string, len(e.Invalid))
+	for i, s := range e.Invalid {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return "invalid mac address: " + strings.Join(quoted, ", ")
+}
+
+// Normalize returns the canonical form of every entry of values, in order. If any entry is not a MAC address it
+// returns an *InvalidError listing all of them.
+func Normalize(values []string) ([]string, error) {
+	out := make([]string, len(values))
+	var invalid []string
+	for i, v := range values {
+		a, err := Parse(v)
+		if err != nil {
+			invalid = append(invalid, v)
+			continue
+		}
+		out[i] = a.String()
+	}
+	if len(invalid) > 0 {
+		return nil, &InvalidError{Invalid: invalid}
+	}
+	return out, nil
+}
@@ -0,0 +1,70 @@
+package mac
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{
+		"5C:22:DA:0E:9F:AB",
+		"5c:22:da:0e:9f:ab",
+		"5c-22-da-0e-9f-ab",
+		"5C22.DA0E.9FAB",
+		"5c22da0e9fab",
+		" 5c22da0e9fab\n",
+	} {
+		a, err := Parse(s)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+			continue
+		}
+		if got := a.String(); got != "5c:22:da:0e:9f:ab" {
+			t.Errorf("%q: got %s", s, got)
+		}
+		if got := a.OUI(); got != "5c:22:da" {
+			t.Errorf("%q: got OUI %s", s, got)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{
+		"",
+		"5c22da0e9f",
+		"5c22da0e9fab00",
+		"5c:22:da-0e:9f:ab",
+		"5c22.da0e-9fab",
+		"5c:22:da:0e:9f:ag",
+		"5c22da0e9fzz",
+		"5c:22:da:0e:9f:ab:",
+		"' OR '1'='1",
+	} {
+		if a, err := Parse(s); err == nil {
+			t.Errorf("%q: parsed as %s", s, a)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	got, err := Normalize([]string{"5C:22:DA:0E:9F:AB", "a4b1.e900.0001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"5c:22:da:0e:9f:ab", "a4:b1:e9:00:00:01"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	_, err = Normalize([]string{"nope", "5c22da0e9fab", "5c22da0e9f"})
+	var invalid *InvalidError
+	if !errors.As(err, &invalid) || !reflect.DeepEqual(invalid.Invalid, []string{"nope", "5c22da0e9f"}) {
+		t.Fatalf("got %v", err)
+	}
+	if want := `invalid mac address: "nope", "5c22da0e9f"`; err.Error() != want {
+		t.Errorf("got %q, want %q", err, want)
+	}
+}
@@ -0,0 +1,178 @@
+package migrations
+
+import (
+	"fmt"
+	"strings"
+)
+
+var __0001_initial_down_sql = []byte(`-- cablemodems is left in place: it holds the inventory, which predates this migration on deployed databases and
+-- cannot be rebuilt from anything migrate knows about. Drop it by hand to start from an empty database.
+DROP TABLE IF EXISTS ts_cm;
+DROP TABLE IF EXISTS ts_reg_state_hourly;
+DROP TABLE IF EXISTS ts_reg_state_minutely;
+`)
+
+func _0001_initial_down_sql() ([]byte, error) {
+	return __0001_initial_down_sql, nil
+}
+
+var __0001_initial_up_sql = []byte(`-- The cablemodems inventory and the time-series tables behind historicalRegState and historicalCm.
+--
+-- Databases deployed before migrations existed already hold cablemodems, so every statement is IF NOT EXISTS: on
+-- those, this migration adopts the table, adds whichever of its indexes are missing, and adds the time-series
+-- tables. The indexes are built without CONCURRENTLY, which cannot run inside the migration's transaction, so on a
+-- large adopted table every index still missing, cablemodems_page_idx above all, blocks writes to cablemodems
+-- while it builds: run it in a maintenance window, or create the indexes CONCURRENTLY by hand beforehand.
+
+-- One row per modem. Columns are listed in the order of the db tags on cablemodems.CableModem.
+CREATE TABLE IF NOT EXISTS cablemodems (
+    mac                  TEXT PRIMARY KEY,
+    cpe_mac              TEXT,
+    mac_domain           TEXT,
+    cable_modem_index    INTEGER,
+    config_file          TEXT,
+    model                TEXT,
+    fiber_node           TEXT,
+    ipv4                 TEXT,
+    ipv6                 TEXT,
+    cpe_ipv4             TEXT,
+    transponder          TEXT,
+    docsis_version       TEXT,
+    ppod                 TEXT,    -- stored upper case; required, besides fqdn, on every CMTS but acr/cbr/smi.
+    fqdn                 TEXT,
+    state                TEXT,
+    not_found_date       TEXT,    -- YYYYMMDD; NULL while the modem is present.
+    reg_state            INTEGER,
+    fn_name              TEXT,
+    number_of_generators INTEGER,
+    rpd_name             TEXT,
+    updated_at           TEXT,
+    bootr                TEXT,
+    vendor               TEXT,
+    sw_rev               TEXT,
+    olt_name             TEXT,
+    pon_name             TEXT,
+    updated_at_ts        INTEGER,
+    is_cpe               BOOLEAN,
+    cmts_type            TEXT,
+    device_type          INTEGER
+);
+
+-- the PRIMARY KEY above is not applied to an adopted table, and upserts need a unique index for ON CONFLICT (mac).
+-- On a fresh table it duplicates the primary key. Building it fails if the adopted table repeats a mac.
+CREATE UNIQUE INDEX IF NOT EXISTS cablemodems_mac_key ON cablemodems (mac);
+CREATE INDEX IF NOT EXISTS cablemodems_fqdn_idx ON cablemodems (fqdn);
+CREATE INDEX IF NOT EXISTS cablemodems_ppod_idx ON cablemodems (ppod);
+CREATE INDEX IF NOT EXISTS cablemodems_fiber_node_idx ON cablemodems (fiber_node);
+-- keyset order of Paged.
+CREATE INDEX IF NOT EXISTS cablemodems_page_idx ON cablemodems ((COALESCE(fqdn, '')), mac);
+
+-- Reg state is rolled up at ingestion time into one row per modem per minute and per hour; each bucket keeps the
+-- latest sample that fell into it. Channel data is kept per poll, with each channel group stored as a JSON array
+-- shaped like the matching TsCmDevice field.
+
+CREATE TABLE IF NOT EXISTS ts_reg_state_minutely (
+    mac        TEXT        NOT NULL,
+    bucket     TIMESTAMPTZ NOT NULL,
+    reg_state  INTEGER     NOT NULL,
+    sampled_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (mac, bucket)
+);
+
+CREATE TABLE IF NOT EXISTS ts_reg_state_hourly (
+    mac        TEXT        NOT NULL,
+    bucket     TIMESTAMPTZ NOT NULL,
+    reg_state  INTEGER     NOT NULL,
+    sampled_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (mac, bucket)
+);
+
+CREATE TABLE IF NOT EXISTS ts_cm (
+    mac                   TEXT        NOT NULL,
+    sampled_at            TIMESTAMPTZ NOT NULL,
+    lost_sync             INTEGER,
+    resets                INTEGER,
+    cable_downstream      JSONB,
+    cable_upstream        JSONB,
+    cable_upstream_status JSONB,
+    ofdm_downstream       JSONB,
+    PRIMARY KEY (mac, sampled_at)
+);
+`)
+
+func _0001_initial_up_sql() ([]byte, error) {
+	return __0001_initial_up_sql, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		return f()
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() ([]byte, error){
+	"0001_initial.down.sql": _0001_initial_down_sql,
+	"0001_initial.up.sql":   _0001_initial_up_sql,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for name := range node.Children {
+		rv = append(rv, name)
+	}
+	return rv, nil
+}
+
+type _bintree_t struct {
+	Func     func() ([]byte, error)
+	Children map[string]*_bintree_t
+}
+
+var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
+	"0001_initial.down.sql": &_bintree_t{_0001_initial_down_sql, map[string]*_bintree_t{}},
+	"0001_initial.up.sql":   &_bintree_t{_0001_initial_up_sql, map[string]*_bintree_t{}},
+}}
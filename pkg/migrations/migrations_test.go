@@ -0,0 +1,113 @@
+package migrations
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"api-project/pkg/cablemodems"
+	"api-project/pkg/sqltest"
+)
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+	all, err := All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) == 0 || all[0].Version != 1 {
+		t.Fatalf("migrations must start at version 1, got %v", all)
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i].Version != all[i-1].Version+1 {
+			t.Errorf("version %d follows %d", all[i].Version, all[i-1].Version)
+		}
+	}
+}
+
+// TestInitialCableModems fails when the cablemodems table and the CableModem struct disagree on the columns
+// or their order.
+func TestInitialCableModems(t *testing.T) {
+	t.Parallel()
+	all, err := All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := regexp.MustCompile(`(?s)CREATE TABLE IF NOT EXISTS cablemodems \((.*?)\n\);`).FindStringSubmatch(all[0].Up)
+	if table == nil {
+		t.Fatal("migration 1 does not create cablemodems")
+	}
+	var columns []string
+	for _, line := range strings.Split(strings.TrimSpace(table[1]), "\n") {
+		columns = append(columns, strings.Fields(line)[0])
+	}
+
+	var want []string
+	typ := reflect.TypeOf(cablemodems.CableModem{})
+	for i := 0; i < typ.NumField(); i++ {
+		if col, ok := typ.Field(i).Tag.Lookup("db"); ok {
+			want = append(want, col)
+		}
+	}
+	if !reflect.DeepEqual(columns, want) {
+		t.Errorf("cablemodems columns:\ngot  %v\nwant %v", columns, want)
+	}
+}
+
+// TestInitialKeepsInventory fails when migration 1 would break on a database deployed before migrations
+// existed, would leave upserts broken there, or when rolling it back would drop the inventory.
+func TestInitialKeepsInventory(t *testing.T) {
+	t.Parallel()
+	all, err := All()
+	if err != nil {
+		t.Fatal(err)
+	}
+	up := all[0].Up
+	plain := strings.Count(up, "CREATE TABLE ") + strings.Count(up, "CREATE INDEX ")
+	guarded := strings.Count(up, "CREATE TABLE IF NOT EXISTS ") + strings.Count(up, "CREATE INDEX IF NOT EXISTS ")
+	if plain != guarded {
+		t.Errorf("%d of the %d CREATE statements of migration 1 lack IF NOT EXISTS", plain-guarded, plain)
+	}
+	// an adopted table lacks the primary key, and upserts need a unique index on mac for ON CONFLICT (mac).
+	if !regexp.MustCompile(`CREATE UNIQUE INDEX IF NOT EXISTS \w+ ON cablemodems \(mac\);`).MatchString(up) {
+		t.Error("migration 1 does not ensure a unique index on cablemodems (mac)")
+	}
+	if regexp.MustCompile(`DROP TABLE (IF EXISTS )?cablemodems\b`).MatchString(all[0].Down) {
+		t.Error("rolling back migration 1 drops cablemodems")
+	}
+}
+
+func TestUpHoldsLock(t *testing.T) {
+	t.Parallel()
+	rec := sqltest.New(t)
+	m, err := New(rec.DB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done, err := m.Up(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != len(m.migrations) {
+		t.Errorf("applied %d of %d migrations on an empty database", len(done), len(m.migrations))
+	}
+
+	statements := rec.Statements()
+	if first := statements[0].Query; !strings.Contains(first, "pg_advisory_lock") {
+		t.Errorf("first statement %q does not take the lock", first)
+	}
+	if last := statements[len(statements)-1].Query; !strings.Contains(last, "pg_advisory_unlock") {
+		t.Errorf("last statement %q does not release the lock", last)
+	}
+	var ups int
+	for _, s := range statements {
+		if s.Query == m.migrations[0].Up {
+			ups++
+		}
+	}
+	if ups != 1 {
+		t.Errorf("migration 1 ran %d times", ups)
+	}
+}
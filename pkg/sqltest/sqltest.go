@@ -0,0 +1,181 @@
+// Package sqltest provides a database/sql pool that records every statement instead of running it, so tests
+// can check exactly what SQL a code path sends and which values it binds.
+package sqltest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+// Hostile are mac / CMTS values crafted to break out of a quoted SQL literal or identifier. None of them may
+// ever show up in a statement's text; they must only be bound.
+var Hostile = []string{
+	`' OR '1'='1`,
+	`5c22da0e9fab' OR 1=1 --`,
+	`'; DROP TABLE cablemodems; --`,
+	`acr01' UNION SELECT * FROM pg_shadow --`,
+	`cbr8" OR ""="`,
+	`smi') OR ('x'='x`,
+	`den01ppod'/**/OR/**/'a'='a`,
+	`$1 OR TRUE`,
+	`\'; SELECT pg_sleep(10); --`,
+	"5c22da0e9fab'\x00--",
+	"5c22da0e9fab'\n; DELETE FROM cablemodems",
+	`' AND (SELECT 1 FROM pg_sleep(5)) IS NULL --`,
+}
+
+// Statement is one query or exec the pool received.
+type Statement struct {
+	Query string
+	Args  []interface{}
+}
+
+// Recorder is a pool whose statements all succeed with no rows. It satisfies cablemodems.Conns.
+type Recorder struct {
+	DB *sql.DB
+
+	mu         sync.Mutex
+	statements []Statement
+}
+
+// New returns a Recorder that is closed when t ends.
+func New(t testing.TB) *Recorder {
+	r := &Recorder{}
+	r.DB = sql.OpenDB(connector{r})
+	t.Cleanup(func() { r.DB.Close() })
+	return r
+}
+
+func (r *Recorder) Reader(context.Context) *sql.DB { return r.DB }
+
+func (r *Recorder) Writer(context.Context) *sql.DB { return r.DB }
+
+// Statements returns what the pool has received so far.
+func (r *Recorder) Statements() []Statement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Statement(nil), r.statements...)
+}
+
+// AssertBound fails t if a statement's text contains value, or if no statement bound it, on its own or as an
+// element of an array parameter.
+func (r *Recorder) AssertBound(t testing.TB, value string) {
+	t.Helper()
+	bound := false
+	for _, s := range r.Statements() {
+		if strings.Contains(s.Query, value) {
+			t.Errorf("%q was spliced into %q", value, s.Query)
+		}
+		for _, a := range s.Args {
+			for _, v := range boundStrings(a) {
+				if strings.EqualFold(v, value) {
+					bound = true
+				}
+			}
+		}
+	}
+	if !bound {
+		t.Errorf("%q was never bound", value)
+	}
+}
+
+// boundStrings returns the strings a bound value carries: itself, or the elements of a pq.Array.
+func boundStrings(arg interface{}) []string {
+	v, ok := arg.(string)
+	if !ok {
+		return nil
+	}
+	var elems pq.StringArray
+	if strings.HasPrefix(v, "{") && elems.Scan(v) == nil {
+		return append(elems, v)
+	}
+	return []string{v}
+}
+
+func (r *Recorder) record(query string, args []driver.NamedValue) {
+	s := Statement{Query: query, Args: make([]interface{}, len(args))}
+	for i, a := range args {
+		s.Args[i] = a.Value
+	}
+	r.mu.Lock()
+	r.statements = append(r.statements, s)
+	r.mu.Unlock()
+}
+
+type connector struct{ r *Recorder }
+
+func (c connector) Connect(context.Context) (driver.Conn, error) { return conn(c), nil }
+
+func (c connector) Driver() driver.Driver { return drv{} }
+
+// drv only exists so that connector can name a driver; the pool always connects through connector.
+type drv struct{}
+
+func (drv) Open(string) (driver.Conn, error) { return nil, errors.New("sqltest: use New") }
+
+type conn struct{ r *Recorder }
+
+func (c conn) Prepare(query string) (driver.Stmt, error) { return stmt{c.r, query}, nil }
+
+func (c conn) Close() error { return nil }
+
+func (c conn) Begin() (driver.Tx, error) { return tx{}, nil }
+
+func (c conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.r.record(query, args)
+	return rows{}, nil
+}
+
+func (c conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.r.record(query, args)
+	return driver.RowsAffected(0), nil
+}
+
+type stmt struct {
+	r     *Recorder
+	query string
+}
+
+func (s stmt) Close() error { return nil }
+
+func (s stmt) NumInput() int { return -1 }
+
+func (s stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.r.record(s.query, named(args))
+	return driver.RowsAffected(0), nil
+}
+
+func (s stmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.r.record(s.query, named(args))
+	return rows{}, nil
+}
+
+func named(args []driver.Value) []driver.NamedValue {
+	nv := make([]driver.NamedValue, len(args))
+	for i, a := range args {
+		nv[i] = driver.NamedValue{Ordinal: i + 1, Value: a}
+	}
+	return nv
+}
+
+type tx struct{}
+
+func (tx) Commit() error { return nil }
+
+func (tx) Rollback() error { return nil }
+
+type rows struct{}
+
+func (rows) Columns() []string { return nil }
+
+func (rows) Close() error { return nil }
+
+func (rows) Next([]driver.Value) error { return io.EOF }
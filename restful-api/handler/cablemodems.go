@@ -1,274 +1,370 @@
 package handler
 
 import (
+	"bufio"
 	"context"
-	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"api-project/pkg/cablemodems"
+	"api-project/pkg/mac"
+
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
+// maxByMacBody 是 POST by-mac 请求体的上限，足够容纳两百万个带分隔符的 mac
+const maxByMacBody = 32 << 20
+
+// statusClientClosedRequest 是 nginx 约定的 499，表示调用方在响应之前断开了连接
+const statusClientClosedRequest = 499
+
 // CableModemsByMac 是对应 GraphQL ByMac 的 RESTful 版本
 func CableModemsByMac(c *gin.Context) {
-	dbVal, ok := c.Get("dbRead")
-	if !ok {
-		c.JSON(http.StatusServiceUnavailable, gin.H{
-			"error": "database connection not available",
-		})
-	}
-	db, ok := dbVal.(*sql.DB)
-	if !ok {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "invalid database connection type",
-		})
-		return
-	}
 	// 获取 mac 参数（支持逗号分隔多个 mac）
 	macParam := c.Query("mac")
 	if macParam == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "mac is required"})
 		return
 	}
+	byMac(c, strings.Split(macParam, ","))
+}
+
+// CableModemsByMacPost 是 by-mac 的 POST 版本，mac 放在请求体中，不受 URL 长度限制：
+// application/json 为字符串数组，text/plain 为每行一个 mac
+func CableModemsByMacPost(c *gin.Context) {
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, maxByMacBody)
+	var values []string
+	switch c.ContentType() {
+	case binding.MIMEJSON:
+		if err := json.NewDecoder(body).Decode(&values); err != nil {
+			bodyError(c, err)
+			return
+		}
+	case binding.MIMEPlain:
+		scanner := bufio.NewScanner(body)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				values = append(values, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			bodyError(c, err)
+			return
+		}
+	default:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/json or text/plain"})
+		return
+	}
+	if len(values) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "mac is required"})
+		return
+	}
+	byMac(c, values)
+}
+
+// byMac 规范化 mac 后查询并写出响应，GET 和 POST 共用
+func byMac(c *gin.Context, values []string) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	macs, ok := normalizeMacs(c, values)
+	if !ok {
+		return
+	}
 
-	macs := strings.Split(macParam, ",")
-	for i := range macs {
-		macs[i] = strings.TrimSpace(macs[i])
+	if streams(c) {
+		streamModems(c, 0, func(fn func(*cablemodems.CableModem) error) error {
+			return repo.EachByMac(c.Request.Context(), macs, fn)
+		})
+		return
 	}
 
-	// 调用 resolver
-	modems, err := ByMacRds(c.Request.Context(), db, macs)
+	modems, err := repo.ByMac(c.Request.Context(), macs)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		repoError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, modems)
 }
 
-// ByMacRds 是直接移植的 GraphQL resolver 核心逻辑
-func ByMacRds(ctx context.Context, db *sql.DB, macAddresses []string) ([]*CableModem, error) {
-	if len(macAddresses) == 0 {
-		return nil, sql.ErrNoRows
+// bodyError 请求体过大时返回 413，其余读取或解析错误返回 400
+func bodyError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("request body exceeds %d bytes", tooLarge.Limit)})
+		return
 	}
-
-	// 分页批量 IN 查询
-	return inRds(ctx, db, "mac", macAddresses, false)
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 }
 
+// CableModemsByCmts 是对应 GraphQL ByCmts 的 RESTful 版本
 func CableModemsByCmts(c *gin.Context) {
-	// 获取 mac 参数
-	macStr := c.Query("mac")
-	if macStr == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "mac is required"})
+	repo, ok := repository(c)
+	if !ok {
 		return
 	}
-	macs := strings.Split(macStr, ",") // 支持多个 mac 用逗号分隔
-
-	// TODO: 数据库查询 macs 相关数据
-
-	c.JSON(http.StatusOK, gin.H{
-		"macs": macs,
-		// "data": 查询到的结果
-	})
-}
+	cmts := strings.TrimSpace(c.Query("cmts"))
+	if cmts == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cmts is required"})
+		return
+	}
+	q := cablemodems.CmtsQuery{Cmts: cmts}
+	if q.State, ok = stateQuery(c); !ok {
+		return
+	}
+	if q.Docsis, ok = docsisQuery(c); !ok {
+		return
+	}
+	if single := c.Query("single"); single != "" {
+		b, err := strconv.ParseBool(single)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid single: " + single})
+			return
+		}
+		q.Single = b
+	}
 
-func CableModemsByPoller(c *gin.Context) {
-	// 获取 mac 参数
-	macStr := c.Query("mac")
-	if macStr == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "mac is required"})
+	if streams(c) {
+		streamModems(c, 0, func(fn func(*cablemodems.CableModem) error) error {
+			return repo.EachByCmts(c.Request.Context(), q, fn)
+		})
 		return
 	}
-	macs := strings.Split(macStr, ",") // 支持多个 mac 用逗号分隔
 
-	// TODO: 数据库查询 macs 相关数据
+	modems, err := repo.ByCmts(c.Request.Context(), q)
+	if err != nil {
+		repoError(c, err)
+		return
+	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"macs": macs,
-		// "data": 查询到的结果
-	})
+	c.JSON(http.StatusOK, modems)
 }
 
-func inRds(ctx context.Context, db *sql.DB, field string, values []string, single bool) ([]*CableModem, error) {
-	if len(values) == 0 {
-		return nil, errors.New("values slice is empty")
+// CableModemsByPoller 是对应 GraphQL ByPoller 的 RESTful 版本
+func CableModemsByPoller(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
 	}
-
-	result := []*CableModem{}
-
-	tempValue := values[0]
-	checkPpodQuery := ""
-	if field == "ppod" {
-		tempValue = strings.ToUpper(values[0])
+	poller := cablemodems.PollerType(c.Query("poller"))
+	if !poller.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid poller: " + string(poller)})
+		return
 	}
-
-	if !(strings.HasPrefix(tempValue, "acr") || strings.HasPrefix(tempValue, "cbr") || strings.HasPrefix(tempValue, "smi")) {
-		checkPpodQuery = "AND ppod IS NOT NULL"
+	cmts := strings.TrimSpace(c.Query("cmts"))
+	if cmts == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cmts is required"})
+		return
+	}
+	q := cablemodems.PollerQuery{Poller: poller, Cmts: cmts}
+	if q.State, ok = stateQuery(c); !ok {
+		return
+	}
+	if q.Docsis, ok = docsisQuery(c); !ok {
+		return
 	}
 
-	if single && len(values) > 0 {
-		query := fmt.Sprintf(`
-			SELECT * 
-			FROM cablemodems
-			WHERE %s = '%s'
-			AND not_found_date IS NULL
-			AND (
-				(ipv4 IS NOT NULL AND ipv4 != '0.0.0.0' AND ipv4 != '') 
-				OR 
-				(ipv6 IS NOT NULL AND ipv6 != '0000:0000:0000:0000:0000:0000:0000:0000' AND ipv6 != '')
-			)
-			%s
-			LIMIT 1;
-		`, field, tempValue, checkPpodQuery)
+	if streams(c) {
+		streamModems(c, 0, func(fn func(*cablemodems.CableModem) error) error {
+			return repo.EachByPoller(c.Request.Context(), q, fn)
+		})
+		return
+	}
 
-		rows, err := db.QueryContext(ctx, query)
-		if err != nil {
-			return nil, err
-		}
-		defer rows.Close()
+	modems, err := repo.ByPoller(c.Request.Context(), q)
+	if err != nil {
+		repoError(c, err)
+		return
+	}
 
-		var cablemodems []*CableModem
-		for rows.Next() {
-			cablemodem := &CableModem{}
-			err := rows.Scan(
-				&cablemodem.Mac,
-				&cablemodem.CpeMac,
-				&cablemodem.MacDomain,
-				&cablemodem.CableModemIndex,
-				&cablemodem.ConfigFile,
-				&cablemodem.Model,
-				&cablemodem.FiberNode,
-				&cablemodem.Ipv4,
-				&cablemodem.Ipv6,
-				&cablemodem.CpeIpv4,
-				&cablemodem.Transponder,
-				&cablemodem.DocsisVersion,
-				&cablemodem.Ppod,
-				&cablemodem.Fqdn,
-				&cablemodem.State,
-				&cablemodem.NotFoundDate,
-				&cablemodem.RegState,
-				&cablemodem.FnName,
-				&cablemodem.NumberOfGenerators,
-				&cablemodem.RpdName,
-				&cablemodem.UpdatedAt,
-				&cablemodem.Bootr,
-				&cablemodem.Vendor,
-				&cablemodem.SwRev,
-				&cablemodem.OltName,
-				&cablemodem.PonName,
-				&cablemodem.UpdatedAtTs,
-				&cablemodem.IsCpe,
-				&cablemodem.CmtsType,
-				&cablemodem.DeviceType,
-			)
-			if err != nil {
-				return nil, err
-			}
-			cablemodems = append(cablemodems, cablemodem)
-		}
+	c.JSON(http.StatusOK, modems)
+}
 
-		err = rows.Err()
-		if err != nil {
-			return nil, err
+// CableModemsPaged 是对应 GraphQL Paged 的 RESTful 版本，返回与 GraphQL 相同结构的 connection。
+// 要求 NDJSON 或 CSV 时不分页，从 after 之后逐条输出所有匹配的 modem，给出 first 时最多输出 first 条
+func CableModemsPaged(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	// limit 只用于 NDJSON 和 CSV，0 表示不限条数
+	first, limit := cablemodems.DefaultPageSize, 0
+	if v := c.Query("first"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || (n < 1 && streams(c)) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid first: " + v})
+			return
 		}
+		first, limit = n, n
+	}
+	filter, ok := filterQuery(c)
+	if !ok {
+		return
+	}
 
-		return cablemodems, nil
+	if streams(c) {
+		streamModems(c, limit, func(fn func(*cablemodems.CableModem) error) error {
+			return repo.EachFiltered(c.Request.Context(), filter, c.Query("after"), fn)
+		})
+		return
 	}
 
-	getRecordsIn := func(ctxArg context.Context, db *sql.DB, field string, values []string, page, pageSize int) ([]*CableModem, error) {
-		offset := page * pageSize
-		placeholderArgs := make([]string, len(values))
-		queryArgs := make([]interface{}, len(values)+2)
-		for i, value := range values {
-			placeholderArgs[i] = fmt.Sprintf("$%d", i+1)
-			queryArgs[i] = value
-		}
-		queryArgs[len(values)] = pageSize
-		queryArgs[len(values)+1] = offset
+	page, err := repo.Paged(c.Request.Context(), filter, first, c.Query("after"))
+	if err != nil {
+		repoError(c, err)
+		return
+	}
 
-		query := fmt.Sprintf(`
-		SELECT *
-		FROM cablemodems
-		WHERE %s IN (%s)
-		ORDER BY fqdn
-		LIMIT $%d OFFSET $%d;
-	`, field, strings.Join(placeholderArgs, ", "), len(values)+1, len(values)+2)
+	c.JSON(http.StatusOK, gin.H{
+		"edges": page.Modems,
+		"pageInfo": gin.H{
+			"hasNextPage": page.HasNextPage,
+			"endCursor":   page.EndCursor,
+		},
+	})
+}
 
-		rows, err := db.QueryContext(ctx, query, queryArgs...)
-		if err != nil {
-			return nil, err
+// filterQuery 从 query 参数构造 Paged 的 filter，参数名与 GraphQL CableModemsFilter 一致，mac 支持逗号分隔
+func filterQuery(c *gin.Context) (*cablemodems.Filter, bool) {
+	optional := func(key string) *string {
+		if v, ok := c.GetQuery(key); ok && v != "" {
+			return &v
 		}
-		defer rows.Close()
-
-		var cablemodems []*CableModem
-		for rows.Next() {
-			cablemodem := &CableModem{}
-			err := rows.Scan(
-				&cablemodem.Mac,
-				&cablemodem.CpeMac,
-				&cablemodem.MacDomain,
-				&cablemodem.CableModemIndex,
-				&cablemodem.ConfigFile,
-				&cablemodem.Model,
-				&cablemodem.FiberNode,
-				&cablemodem.Ipv4,
-				&cablemodem.Ipv6,
-				&cablemodem.CpeIpv4,
-				&cablemodem.Transponder,
-				&cablemodem.DocsisVersion,
-				&cablemodem.Ppod,
-				&cablemodem.Fqdn,
-				&cablemodem.State,
-				&cablemodem.NotFoundDate,
-				&cablemodem.RegState,
-				&cablemodem.FnName,
-				&cablemodem.NumberOfGenerators,
-				&cablemodem.RpdName,
-				&cablemodem.UpdatedAt,
-				&cablemodem.Bootr,
-				&cablemodem.Vendor,
-				&cablemodem.SwRev,
-				&cablemodem.OltName,
-				&cablemodem.PonName,
-				&cablemodem.UpdatedAtTs,
-				&cablemodem.IsCpe,
-				&cablemodem.CmtsType,
-				&cablemodem.DeviceType,
-			)
-			if err != nil {
-				return nil, err
-			}
-			cablemodems = append(cablemodems, cablemodem)
+		return nil
+	}
+	filter := &cablemodems.Filter{
+		DsInterface: optional("dsInterface"),
+		Fqdn:        optional("fqdn"),
+		Ppod:        optional("ppod"),
+		FiberNode:   optional("fiberNode"),
+		MacDomain:   optional("macDomain"),
+	}
+	if v := c.Query("docsisVersion"); v != "" {
+		docsis := cablemodems.DocsisVersion(v)
+		if !docsis.IsValid() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid docsisVersion: " + v})
+			return nil, false
 		}
-
-		err = rows.Err()
+		filter.DocsisVersion = &docsis
+	}
+	if v := c.Query("transponder"); v != "" {
+		b, err := strconv.ParseBool(v)
 		if err != nil {
-			return nil, err
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transponder: " + v})
+			return nil, false
 		}
+		filter.Transponder = &b
+	}
+	if v := c.Query("mac"); v != "" {
+		macs := strings.Split(v, ",")
+		if len(macs) == 1 {
+			filter.MacAddress = &cablemodems.StringFilterEqIn{Eq: &macs[0]}
+		} else {
+			filter.MacAddress = &cablemodems.StringFilterEqIn{In: macs}
+		}
+	}
+	if err := filter.NormalizeMacs(); err != nil {
+		invalidMacs(c, err)
+		return nil, false
+	}
+	return filter, true
+}
 
-		return cablemodems, nil
+// normalizeMacs 把任意常见写法的 mac 转成数据库中的规范格式，有非法 mac 时已写好 400 响应
+func normalizeMacs(c *gin.Context, values []string) ([]string, bool) {
+	macs, err := mac.Normalize(values)
+	if err != nil {
+		invalidMacs(c, err)
+		return nil, false
 	}
+	return macs, true
+}
 
-	page := 0
-	pageSize := 100_000
-	for {
-		records, err := getRecordsIn(ctx, db, field, values, page, pageSize)
-		if err != nil {
-			return nil, err
-		}
+// invalidMacs 写 400 响应，invalid 列出所有非法的 mac
+func invalidMacs(c *gin.Context, err error) {
+	var invalid *mac.InvalidError
+	if errors.As(err, &invalid) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "invalid": invalid.Invalid})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
 
-		if len(records) == 0 {
-			break
-		}
+// repoError 把仓库返回的错误映射为 HTTP 状态码。ErrUnfilterableColumn 是服务端的 bug，按 500 处理；
+// 调用方取消和超时分别对应 gRPC 的 Canceled 和 DeadlineExceeded
+func repoError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, context.Canceled):
+		c.JSON(statusClientClosedRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, context.DeadlineExceeded):
+		c.JSON(http.StatusGatewayTimeout, gin.H{"error": err.Error()})
+	case errors.Is(err, cablemodems.ErrNotReady):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	case errors.Is(err, cablemodems.ErrEmptyValues),
+		errors.Is(err, cablemodems.ErrMissingCmts),
+		errors.Is(err, cablemodems.ErrUnknownPoller),
+		errors.Is(err, cablemodems.ErrInvalidCursor),
+		errors.Is(err, cablemodems.ErrInvalidFirst),
+		errors.Is(err, cablemodems.ErrInvalidPeriod),
+		errors.Is(err, cablemodems.ErrInvalidTimeRange),
+		errors.Is(err, cablemodems.ErrEmptyPatch),
+		errors.Is(err, cablemodems.ErrDuplicateMac):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
 
-		result = append(result, records...)
+// stateQuery 解析可选的 state 参数，非法值时已写好 400 响应
+func stateQuery(c *gin.Context) (*cablemodems.State, bool) {
+	v := c.Query("state")
+	if v == "" {
+		return nil, true
+	}
+	state := cablemodems.State(v)
+	if !state.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid state: " + v})
+		return nil, false
+	}
+	return &state, true
+}
 
-		page++
+// docsisQuery 解析可选的 docsis 参数，非法值时已写好 400 响应
+func docsisQuery(c *gin.Context) (*cablemodems.DocsisVersion, bool) {
+	v := c.Query("docsis")
+	if v == "" {
+		return nil, true
+	}
+	docsis := cablemodems.DocsisVersion(v)
+	if !docsis.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid docsis: " + v})
+		return nil, false
 	}
+	return &docsis, true
+}
 
-	return result, nil
+// repository 取出中间件注入的 cablemodems 仓库，失败时已写好错误响应
+func repository(c *gin.Context) (cablemodems.Store, bool) {
+	val, ok := c.Get("cablemodems")
+	if !ok {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error": "database connection not available",
+		})
+		return nil, false
+	}
+	repo, ok := val.(cablemodems.Store)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "invalid database connection type",
+		})
+		return nil, false
+	}
+	return repo, true
 }
@@ -11,3 +11,14 @@ func Ping(c *gin.Context) {
 		"message": "pong",
 	})
 }
+
+// Ready 在数据库连上之前返回 503，供编排系统做 readiness 检查
+func Ready(ready func() bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !ready() {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "not ready"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"status": "ready"})
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"api-project/pkg/cablemodems"
+
+	"github.com/gin-gonic/gin"
+)
+
+// regStateSamples 是 poller 上报的一批 reg state 采样，time 为 unix 秒，缺省为当前时间
+type regStateSamples struct {
+	Time    int64                           `json:"time"`
+	Devices []*cablemodems.TsRegStateDevice `json:"devices" binding:"required"`
+}
+
+// cmSamples 是 poller 上报的一批信道采样，time 为 unix 秒，缺省为当前时间
+type cmSamples struct {
+	Time    int64                     `json:"time"`
+	Devices []*cablemodems.TsCmDevice `json:"devices" binding:"required"`
+}
+
+func sampledAt(unix int64) time.Time {
+	if unix == 0 {
+		return time.Now()
+	}
+	return time.Unix(unix, 0)
+}
+
+// normalizeDeviceMacs 把采样的 mac 就地规范化，跳过 nil，有非法 mac 时已写好 400 响应
+func normalizeDeviceMacs(c *gin.Context, macs []*string) bool {
+	var ptrs []*string
+	var values []string
+	for _, p := range macs {
+		if p != nil {
+			ptrs = append(ptrs, p)
+			values = append(values, *p)
+		}
+	}
+	normalized, ok := normalizeMacs(c, values)
+	if !ok {
+		return false
+	}
+	for i, p := range ptrs {
+		*p = normalized[i]
+	}
+	return true
+}
+
+// RecordRegState 写入 historicalRegState 使用的 reg state 采样
+func RecordRegState(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	var body regStateSamples
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	macs := make([]*string, 0, len(body.Devices))
+	for _, d := range body.Devices {
+		if d != nil {
+			macs = append(macs, d.Mac)
+		}
+	}
+	if !normalizeDeviceMacs(c, macs) {
+		return
+	}
+
+	if err := repo.RecordRegState(c.Request.Context(), sampledAt(body.Time), body.Devices); err != nil {
+		repoError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RecordCm 写入 historicalCm 使用的信道采样
+func RecordCm(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	var body cmSamples
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	macs := make([]*string, 0, len(body.Devices))
+	for _, d := range body.Devices {
+		if d != nil {
+			macs = append(macs, d.Mac)
+		}
+	}
+	if !normalizeDeviceMacs(c, macs) {
+		return
+	}
+
+	if err := repo.RecordCm(c.Request.Context(), sampledAt(body.Time), body.Devices); err != nil {
+		repoError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"api-project/pkg/cablemodems"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+const (
+	// mimeNDJSON 是逐行一个 JSON 对象的流式响应格式
+	mimeNDJSON = "application/x-ndjson"
+	// mimeCSV 是给表格软件使用的导出格式，表头为 CableModem 的 JSON 字段名
+	mimeCSV = "text/csv"
+)
+
+// flushEvery 是流式响应每写多少条 modem 就把已写的内容推给客户端，而不是等缓冲区写满
+const flushEvery = 100
+
+// streamErrorTrailer 是响应开始写出后才出错时携带错误信息的 trailer，此时状态码已经无法修改
+const streamErrorTrailer = "X-Stream-Error"
+
+// errEnough 表示流已经写够了调用方要求的条数
+var errEnough = errors.New("enough modems streamed")
+
+// modemEncoder 把 modem 逐条编码到响应中
+type modemEncoder interface {
+	// begin 在第一条之前调用一次，没有结果时也会调用
+	begin() error
+	encode(m *cablemodems.CableModem) error
+	// flush 把已经编码的内容写给客户端
+	flush() error
+	end() error
+	// fail 在已经写出内容后把错误附在响应末尾
+	fail(err error)
+}
+
+// streams 判断 Accept 头是否要求 NDJSON 或 CSV。没有 Accept 头，或者三种格式都不接受时仍返回 JSON
+func streams(c *gin.Context) bool {
+	switch c.NegotiateFormat(binding.MIMEJSON, mimeNDJSON, mimeCSV) {
+	case mimeNDJSON, mimeCSV:
+		return true
+	default:
+		return false
+	}
+}
+
+// streamModems 按 Accept 头把 each 扫描到的 modem 逐条写成 NDJSON 或 CSV，不在内存中累积结果。
+// fields 参数选择输出的字段，limit 为 0 时不限条数。客户端断开时请求的 context 被取消，查询随之停止。
+// 写出第一条之前的错误照常映射为状态码，之后的错误写入 X-Stream-Error trailer，NDJSON 还会追加一行 {"error": ...}
+func streamModems(c *gin.Context, limit int, each func(fn func(*cablemodems.CableModem) error) error) {
+	fields, ok := fieldsQuery(c)
+	if !ok {
+		return
+	}
+	contentType := c.NegotiateFormat(mimeNDJSON, mimeCSV)
+	var enc modemEncoder
+	if contentType == mimeCSV {
+		contentType += "; charset=utf-8"
+		enc = &csvEncoder{c: c, fields: fields, w: csv.NewWriter(c.Writer)}
+	} else {
+		enc = &ndjsonEncoder{c: c, fields: fields}
+	}
+
+	started := false
+	start := func() error {
+		started = true
+		c.Header("Content-Type", contentType)
+		c.Header("Trailer", streamErrorTrailer)
+		c.Status(http.StatusOK)
+		return enc.begin()
+	}
+	n := 0
+	err := each(func(m *cablemodems.CableModem) error {
+		if limit > 0 && n == limit {
+			return errEnough
+		}
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		n++
+		if err := enc.encode(m); err != nil {
+			return err
+		}
+		if n%flushEvery == 0 {
+			return enc.flush()
+		}
+		return nil
+	})
+	if errors.Is(err, errEnough) {
+		err = nil
+	}
+	if err == nil && !started {
+		err = start()
+	}
+	if err == nil {
+		err = enc.end()
+	}
+	switch {
+	case err == nil:
+	case !started:
+		repoError(c, err)
+	default:
+		enc.fail(err)
+		c.Writer.Header().Set(streamErrorTrailer, err.Error())
+	}
+}
+
+// fieldsQuery 解析 fields 参数：逗号分隔的 CableModem JSON 字段名，可重复给出，缺省为全部字段。
+// 非法字段时已写好 400 响应
+func fieldsQuery(c *gin.Context) (cablemodems.Fields, bool) {
+	var names []string
+	for _, v := range c.QueryArray("fields") {
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	fields, err := cablemodems.ParseFields(names)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return fields, true
+}
+
+// ndjsonEncoder 每行写一个只含所选字段的 JSON 对象
+type ndjsonEncoder struct {
+	c      *gin.Context
+	fields cablemodems.Fields
+}
+
+func (e *ndjsonEncoder) begin() error { return nil }
+
+func (e *ndjsonEncoder) encode(m *cablemodems.CableModem) error {
+	line, err := e.fields.JSON(m)
+	if err != nil {
+		return err
+	}
+	_, err = e.c.Writer.Write(append(line, '\n'))
+	return err
+}
+
+func (e *ndjsonEncoder) flush() error {
+	e.c.Writer.Flush()
+	return nil
+}
+
+func (e *ndjsonEncoder) end() error { return nil }
+
+func (e *ndjsonEncoder) fail(err error) {
+	json.NewEncoder(e.c.Writer).Encode(gin.H{"error": err.Error()})
+}
+
+// csvEncoder 先写表头再每行写一条 modem，NULL 为空单元格，与 .csv fixture 的格式一致
+type csvEncoder struct {
+	c      *gin.Context
+	fields cablemodems.Fields
+	w      *csv.Writer
+	record []string
+}
+
+func (e *csvEncoder) begin() error {
+	e.c.Header("Content-Disposition", `attachment; filename="cablemodems.csv"`)
+	return e.w.Write(e.fields.Names())
+}
+
+func (e *csvEncoder) encode(m *cablemodems.CableModem) error {
+	e.record = e.fields.Record(m, e.record)
+	return e.w.Write(e.record)
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	e.c.Writer.Flush()
+	return e.w.Error()
+}
+
+func (e *csvEncoder) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// fail 写出已经编码的行，错误只出现在 trailer 中
+func (e *csvEncoder) fail(error) { e.w.Flush() }
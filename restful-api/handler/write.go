@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-project/pkg/cablemodems"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxBatchUpsert 是一次 batchUpsert 最多接受的记录数
+const maxBatchUpsert = 10_000
+
+// batchUpsert 是 batchUpsert 的请求体，每条记录的 updatedAtTs 是调用方上次读到的版本，给出时做乐观并发检查
+type batchUpsert struct {
+	Modems []*cablemodems.CableModem `json:"modems" binding:"required"`
+}
+
+// CableModemPut 用请求体整体替换（不存在时创建）路径上的 modem，带 If-Match 时要求 updatedAtTs 未变
+func CableModemPut(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	mac, ok := pathMac(c)
+	if !ok {
+		return
+	}
+	var body cablemodems.CableModem
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bodyMatchesPath(c, &body, mac) || !validModems(c, []*cablemodems.CableModem{&body}) {
+		return
+	}
+	versions, ok := ifMatch(c, mac)
+	if !ok {
+		return
+	}
+	// 更新时间总是由服务端写入
+	body.UpdatedAt, body.UpdatedAtTs = nil, nil
+
+	written, err := repo.Upsert(c.Request.Context(), []*cablemodems.CableModem{&body}, versions)
+	if err != nil {
+		writeError(c, err, http.StatusPreconditionFailed)
+		return
+	}
+	writtenModem(c, written)
+}
+
+// CableModemPatch 只修改请求体中给出的非 null 字段，带 If-Match 时要求 updatedAtTs 未变
+func CableModemPatch(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	mac, ok := pathMac(c)
+	if !ok {
+		return
+	}
+	var patch cablemodems.CableModem
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !bodyMatchesPath(c, &patch, mac) || !validModems(c, []*cablemodems.CableModem{&patch}) {
+		return
+	}
+	versions, ok := ifMatch(c, mac)
+	if !ok {
+		return
+	}
+
+	updated, err := repo.Update(c.Request.Context(), []string{mac}, &patch, versions)
+	if err != nil {
+		writeError(c, err, http.StatusPreconditionFailed)
+		return
+	}
+	writtenModem(c, updated)
+}
+
+// CableModemDelete 软删除：把 not_found_date 设为今天，记录本身保留，带 If-Match 时要求 updatedAtTs 未变
+func CableModemDelete(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	mac, ok := pathMac(c)
+	if !ok {
+		return
+	}
+	versions, ok := ifMatch(c, mac)
+	if !ok {
+		return
+	}
+
+	gone, err := repo.MarkNotFound(c.Request.Context(), []string{mac}, time.Now().Format("20060102"), versions)
+	if err != nil {
+		writeError(c, err, http.StatusPreconditionFailed)
+		return
+	}
+	writtenModem(c, gone)
+}
+
+// CableModemsMethod 分发 /cablemodems:<method> 形式的自定义方法。gin 把冒号后的部分当作路径参数，
+// 所以参数值带着冒号
+func CableModemsMethod(c *gin.Context) {
+	switch c.Param("method") {
+	case ":batchUpsert":
+		CableModemsBatchUpsert(c)
+	default:
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown method: " + c.Param("method")})
+	}
+}
+
+// CableModemsBatchUpsert 在一个事务里写入一批 modem，任何一条版本冲突时整批不写并返回 409
+func CableModemsBatchUpsert(c *gin.Context) {
+	repo, ok := repository(c)
+	if !ok {
+		return
+	}
+	var body batchUpsert
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if n := len(body.Modems); n == 0 || n > maxBatchUpsert {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("modems must hold between 1 and %d records", maxBatchUpsert)})
+		return
+	}
+	if !validModems(c, body.Modems) {
+		return
+	}
+
+	versions := cablemodems.Versions{}
+	for _, m := range body.Modems {
+		if m.UpdatedAtTs != nil {
+			versions[m.Mac] = *m.UpdatedAtTs
+		}
+		m.UpdatedAt, m.UpdatedAtTs = nil, nil
+	}
+
+	written, err := repo.Upsert(c.Request.Context(), body.Modems, versions)
+	if err != nil {
+		writeError(c, err, http.StatusConflict)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"modems": written})
+}
+
+// pathMac 规范化路径上的 mac，非法时已写好 400 响应
+func pathMac(c *gin.Context) (string, bool) {
+	macs, ok := normalizeMacs(c, []string{c.Param("mac")})
+	if !ok {
+		return "", false
+	}
+	return macs[0], true
+}
+
+// bodyMatchesPath 要求请求体的 mac 为空或与路径一致，并把它设为路径上的 mac，不一致时已写好 400 响应
+func bodyMatchesPath(c *gin.Context, m *cablemodems.CableModem, mac string) bool {
+	if m.Mac != "" {
+		macs, ok := normalizeMacs(c, []string{m.Mac})
+		if !ok {
+			return false
+		}
+		if macs[0] != mac {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "mac in the body does not match the path"})
+			return false
+		}
+	}
+	m.Mac = mac
+	return true
+}
+
+// validModems 就地规范化每条记录的 mac 和 cpeMac 并检查枚举字段，非法时已写好 400 响应
+func validModems(c *gin.Context, modems []*cablemodems.CableModem) bool {
+	var macs []*string
+	for i, m := range modems {
+		if m == nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("modems[%d] is null", i)})
+			return false
+		}
+		if m.State != nil && !m.State.IsValid() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid state: " + string(*m.State)})
+			return false
+		}
+		if m.DocsisVersion != nil && !m.DocsisVersion.IsValid() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid docsisVersion: " + string(*m.DocsisVersion)})
+			return false
+		}
+		macs = append(macs, &m.Mac, m.CpeMac)
+	}
+	return normalizeDeviceMacs(c, macs)
+}
+
+// ifMatch 解析可选的 If-Match 头，其值是之前响应的 ETag，即 updatedAtTs。非法时已写好 400 响应
+func ifMatch(c *gin.Context, mac string) (cablemodems.Versions, bool) {
+	v := c.GetHeader("If-Match")
+	if v == "" {
+		return nil, true
+	}
+	ts, err := strconv.ParseInt(strings.Trim(v, `"`), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "If-Match must be a single ETag returned by this API: " + v})
+		return nil, false
+	}
+	return cablemodems.Versions{mac: int32(ts)}, true
+}
+
+// writtenModem 返回单条写入结果并带上 ETag，记录不存在时返回 404
+func writtenModem(c *gin.Context, modems []*cablemodems.CableModem) {
+	if len(modems) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "cable modem not found"})
+		return
+	}
+	m := modems[0]
+	if m.UpdatedAtTs != nil {
+		c.Header("ETag", strconv.Quote(strconv.Itoa(int(*m.UpdatedAtTs))))
+	}
+	c.JSON(http.StatusOK, m)
+}
+
+// writeError 把写操作的错误映射为 HTTP 状态码，版本冲突使用 conflict 状态码并列出冲突的 mac
+func writeError(c *gin.Context, err error, conflict int) {
+	var conflicts *cablemodems.ConflictError
+	if errors.As(err, &conflicts) {
+		c.JSON(conflict, gin.H{"error": err.Error(), "conflicts": conflicts.Conflicts})
+		return
+	}
+	repoError(c, err)
+}
@@ -1,20 +1,56 @@
 package main
 
 import (
+	"api-project/grpc-api/gateway"
+	"api-project/pkg/cablemodems"
 	"api-project/pkg/dbservice"
+	"api-project/restful-api/handler"
 	"api-project/restful-api/router"
+	"context"
+	"expvar"
+	"flag"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
 )
 
 func main() {
+	fixture := flag.String("fixture", "", "serve the modems of this .json or .csv fixture from memory instead of Postgres")
+	flag.Parse()
+
 	r := gin.Default()
-	dbService := dbservice.DbService
+	var repo cablemodems.Store
+	ready := func() bool { return true }
+	if *fixture != "" {
+		modems, err := cablemodems.LoadFixture(*fixture)
+		if err != nil {
+			log.Fatal().Err(err).Msg("load fixture")
+		}
+		repo = cablemodems.NewMemory(modems)
+	} else {
+		dbService := dbservice.New(context.Background(), dbservice.OptionsFromEnv())
+		repo = cablemodems.NewPostgres(dbService)
+		ready = dbService.Ready
+	}
 	r.Use(func(c *gin.Context) {
-		c.Set("dbRead", dbService.DbReader)
-		c.Set("dbWrite", dbService.DbWriter)
+		c.Set("cablemodems", repo)
 		c.Next()
 	})
+	r.GET("/ready", handler.Ready(ready))
+	r.GET("/debug/vars", gin.WrapH(expvar.Handler()))
 	router.SetupRouter(r)
-	r.Run(":8080")
+
+	// /v1 下是 grpc-gateway 从 cablemodems.proto 生成的 HTTP/JSON 接口，每个 RPC 都可以通过 REST 访问
+	gw, stopGateway, err := gateway.New(context.Background(), repo)
+	if err != nil {
+		log.Fatal().Err(err).Msg("start grpc gateway")
+	}
+	defer stopGateway()
+	r.Any("/v1/*path", gin.WrapH(gw))
+
+	// 每个请求带一个 read-your-writes 会话：写过的调用方把响应里的 X-Last-Write 带回来，之后的读在窗口内走主库
+	if err := http.ListenAndServe(":8080", dbservice.SessionHandler(r)); err != nil {
+		log.Fatal().Err(err).Msg("serve")
+	}
 }
@@ -0,0 +1,191 @@
+package router
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"api-project/pkg/cablemodems"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCSVExport(t *testing.T) {
+	r := newTestRouter(t)
+	for _, tt := range []struct {
+		name    string
+		target  string
+		code    int
+		records [][]string
+	}{
+		{"by-cmts fields", "/api/v1/cablemodems/by-cmts?cmts=den01&fields=mac,ppod,cpeMac", 200, [][]string{
+			{"mac", "ppod", "cpeMac"},
+			{"a4:b1:e9:00:00:01", "DEN01", "a4:b1:e9:00:00:81"},
+			{"a4:b1:e9:00:00:02", "DEN01", ""},
+		}},
+		{"repeated fields", "/api/v1/cablemodems/by-mac?mac=5c:22:da:0e:9f:03&fields=mac&fields=state,docsisVersion", 200, [][]string{
+			{"mac", "state", "docsisVersion"},
+			{"5c:22:da:0e:9f:03", "Offline", "Docsis3"},
+		}},
+		{"paged", "/api/v1/cablemodems?fiberNode=FN-102&fields=notFoundDate,mac", 200, [][]string{
+			{"notFoundDate", "mac"},
+			{"", "5c:22:da:0e:9f:03"},
+			{"20260901", "5c:22:da:0e:9f:04"},
+		}},
+		{"empty has a header", "/api/v1/cablemodems/by-poller?poller=RX_MER&cmts=den02.example.net&fields=mac", 200, [][]string{{"mac"}}},
+		{"unknown field", "/api/v1/cablemodems/by-cmts?cmts=den01&fields=mac,is_cpe", 400, nil},
+		{"bad query", "/api/v1/cablemodems/by-cmts?fields=mac", 400, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req.Header.Set("Accept", "text/csv")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != tt.code {
+				t.Fatalf("got %d %s, want %d", w.Code, w.Body, tt.code)
+			}
+			if tt.records == nil {
+				return
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "text/csv; charset=utf-8" {
+				t.Errorf("Content-Type %q", ct)
+			}
+			records, err := csv.NewReader(w.Body).ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(records, tt.records) {
+				t.Errorf("got %q, want %q", records, tt.records)
+			}
+		})
+	}
+}
+
+func TestNDJSONFields(t *testing.T) {
+	r := newTestRouter(t)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cablemodems/by-mac?mac=5c:22:da:0e:9f:04,a4:b1:e9:00:00:03&fields=notFoundDate,mac", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	want := `{"notFoundDate":"20260901","mac":"5c:22:da:0e:9f:04"}` + "\n" + `{"mac":"a4:b1:e9:00:00:03"}` + "\n"
+	if w.Code != http.StatusOK || w.Body.String() != want {
+		t.Errorf("got %d %q, want %q", w.Code, w.Body, want)
+	}
+}
+
+// failingStore fails a CMTS lookup after the modems it was given.
+type failingStore struct {
+	cablemodems.Store
+	err error
+}
+
+func (s failingStore) EachByCmts(ctx context.Context, q cablemodems.CmtsQuery, fn func(*cablemodems.CableModem) error) error {
+	if err := s.Store.EachByCmts(ctx, q, fn); err != nil {
+		return err
+	}
+	return s.err
+}
+
+func TestStreamFailsAfterRows(t *testing.T) {
+	modems, err := cablemodems.LoadFixture("../../pkg/cablemodems/testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("cablemodems", cablemodems.Store(failingStore{cablemodems.NewMemory(modems), errors.New("connection reset")}))
+		c.Next()
+	})
+	SetupRouter(r)
+
+	for _, tt := range []struct {
+		accept string
+		last   string
+	}{
+		{"text/csv", "a4:b1:e9:00:00:02\n"},
+		{"application/x-ndjson", `{"error":"connection reset"}` + "\n"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/cablemodems/by-cmts?cmts=den01&fields=mac", nil)
+		req.Header.Set("Accept", tt.accept)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		res := w.Result()
+		// the rows already written stay, and the error follows them.
+		if res.StatusCode != http.StatusOK || !strings.HasSuffix(w.Body.String(), tt.last) {
+			t.Errorf("%s: got %d %q", tt.accept, res.StatusCode, w.Body)
+		}
+		if got := res.Trailer.Get("X-Stream-Error"); got != "connection reset" {
+			t.Errorf("%s: got trailer %q", tt.accept, got)
+		}
+	}
+}
+
+func TestUnacceptableFormatFallsBackToJSON(t *testing.T) {
+	r := newTestRouter(t)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cablemodems/by-cmts?cmts=den01", nil)
+	req.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var modems []cablemodems.CableModem
+	if err := json.Unmarshal(w.Body.Bytes(), &modems); err != nil {
+		t.Fatalf("%d %q: %v", w.Code, w.Body, err)
+	}
+	if ct := w.Header().Get("Content-Type"); w.Code != http.StatusOK || ct != "application/json; charset=utf-8" || len(modems) != 2 {
+		t.Errorf("got %d %q %s", w.Code, ct, w.Body)
+	}
+}
+
+// manyStore streams n modems behind every CMTS.
+type manyStore struct {
+	cablemodems.Store
+	n int
+}
+
+func (s manyStore) EachByCmts(ctx context.Context, q cablemodems.CmtsQuery, fn func(*cablemodems.CableModem) error) error {
+	for i := 0; i < s.n; i++ {
+		if err := fn(&cablemodems.CableModem{Mac: fmt.Sprintf("5c:22:da:00:%02x:%02x", i>>8, i&0xff)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushRecorder notes how much of the body had been written at every flush.
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	flushed []int
+}
+
+func (w *flushRecorder) Flush() {
+	w.flushed = append(w.flushed, w.Body.Len())
+	w.ResponseRecorder.Flush()
+}
+
+func TestStreamFlushesAsItGoes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("cablemodems", cablemodems.Store(manyStore{n: 1000}))
+		c.Next()
+	})
+	SetupRouter(r)
+
+	for _, accept := range []string{"text/csv", "application/x-ndjson"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/cablemodems/by-cmts?cmts=den01&fields=mac", nil)
+		req.Header.Set("Accept", accept)
+		w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+		r.ServeHTTP(w, req)
+		// rows reach the client while the lookup still scans, not only once it is done.
+		if w.Code != http.StatusOK || len(w.flushed) < 2 || w.flushed[0] == 0 || w.flushed[0] >= w.Body.Len() {
+			t.Errorf("%s: got %d, flushed at %v of %d bytes", accept, w.Code, w.flushed, w.Body.Len())
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"api-project/pkg/cablemodems"
+	"api-project/pkg/sqltest"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestHostileQueryParamsAreBound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, tt := range []struct {
+		path  string
+		param string
+		fixed url.Values
+		// mac params are rejected before any query runs.
+		mac bool
+	}{
+		{"/api/v1/cablemodems/by-mac", "mac", nil, true},
+		{"/api/v1/cablemodems/by-cmts", "cmts", nil, false},
+		{"/api/v1/cablemodems/by-cmts", "cmts", url.Values{"single": {"true"}}, false},
+		{"/api/v1/cablemodems/by-poller", "cmts", url.Values{"poller": {"RX_MER"}}, false},
+		{"/api/v1/cablemodems", "mac", nil, true},
+		{"/api/v1/cablemodems", "fqdn", nil, false},
+		{"/api/v1/cablemodems", "ppod", nil, false},
+		{"/api/v1/cablemodems", "macDomain", nil, false},
+		{"/api/v1/cablemodems", "dsInterface", nil, false},
+		{"/api/v1/cablemodems", "fiberNode", nil, false},
+	} {
+		t.Run(tt.path+"?"+tt.param, func(t *testing.T) {
+			for _, v := range sqltest.Hostile {
+				rec := sqltest.New(t)
+				r := gin.New()
+				r.Use(func(c *gin.Context) {
+					c.Set("cablemodems", cablemodems.NewPostgres(rec))
+					c.Next()
+				})
+				SetupRouter(r)
+
+				q := url.Values{tt.param: {v}}
+				for k, vs := range tt.fixed {
+					q[k] = vs
+				}
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path+"?"+q.Encode(), nil))
+				if tt.mac {
+					if w.Code != http.StatusBadRequest || len(rec.Statements()) != 0 {
+						t.Errorf("%q: got %d %s, want 400 without touching the database", v, w.Code, w.Body)
+					}
+					continue
+				}
+				if w.Code != http.StatusOK {
+					t.Errorf("%q: got %d %s", v, w.Code, w.Body)
+					continue
+				}
+				rec.AssertBound(t, v)
+			}
+		})
+	}
+}
@@ -11,10 +11,19 @@ func SetupRouter(r *gin.Engine) *gin.Engine {
 	{
 		cm := api.Group("/cablemodems")
 		{
+			cm.GET("", handler.CableModemsPaged)
 			cm.GET("/by-mac", handler.CableModemsByMac)
+			cm.POST("/by-mac", handler.CableModemsByMacPost)
 			cm.GET("/by-cmts", handler.CableModemsByCmts)
 			cm.GET("/by-poller", handler.CableModemsByPoller)
+			cm.POST("/historical/reg-state", handler.RecordRegState)
+			cm.POST("/historical/cm", handler.RecordCm)
+			cm.PUT("/:mac", handler.CableModemPut)
+			cm.PATCH("/:mac", handler.CableModemPatch)
+			cm.DELETE("/:mac", handler.CableModemDelete)
 		}
+		// 自定义方法，例如 POST /api/v1/cablemodems:batchUpsert
+		api.POST("/cablemodems:method", handler.CableModemsMethod)
 	}
 
 	return r
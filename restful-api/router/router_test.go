@@ -0,0 +1,310 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"api-project/pkg/cablemodems"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newTestRouter serves the shared modem fixture from memory.
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	modems, err := cablemodems.LoadFixture("../../pkg/cablemodems/testdata/modems.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := cablemodems.NewMemory(modems)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("cablemodems", cablemodems.Store(repo))
+		c.Next()
+	})
+	return SetupRouter(r)
+}
+
+func TestRoutes(t *testing.T) {
+	r := newTestRouter(t)
+	for _, tt := range []struct {
+		name   string
+		method string
+		target string
+		body   string
+		code   int
+		macs   []string // the modems of a 200 response, in order
+	}{
+		{"by-mac", "GET", "/api/v1/cablemodems/by-mac?mac=5c:22:da:0e:9f:01,%20a4b1e9000003", "", 200,
+			[]string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"by-mac unknown", "GET", "/api/v1/cablemodems/by-mac?mac=ff:ff:ff:ff:ff:ff", "", 200, []string{}},
+		{"by-mac missing", "GET", "/api/v1/cablemodems/by-mac", "", 400, nil},
+		{"by-mac notations", "GET", "/api/v1/cablemodems/by-mac?mac=5C:22:DA:0E:9F:01,a4b1.e900.0003", "", 200,
+			[]string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"by-mac invalid", "GET", "/api/v1/cablemodems/by-mac?mac=5c:22:da:0e:9f:01,nope", "", 400, nil},
+		{"by-cmts", "GET", "/api/v1/cablemodems/by-cmts?cmts=acr01.den.example.net", "", 200,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"by-cmts mixed case", "GET", "/api/v1/cablemodems/by-cmts?cmts=ACR01.Den.Example.NET", "", 200,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"by-cmts ppod", "GET", "/api/v1/cablemodems/by-cmts?cmts=den01", "", 200,
+			[]string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"by-cmts filters", "GET", "/api/v1/cablemodems/by-cmts?cmts=acr01.den.example.net&state=Offline&docsis=Docsis3", "", 200,
+			[]string{"5c:22:da:0e:9f:03"}},
+		{"by-cmts single", "GET", "/api/v1/cablemodems/by-cmts?cmts=acr01.den.example.net&single=true", "", 200,
+			[]string{"5c:22:da:0e:9f:01"}},
+		{"by-cmts missing", "GET", "/api/v1/cablemodems/by-cmts", "", 400, nil},
+		{"by-cmts bad state", "GET", "/api/v1/cablemodems/by-cmts?cmts=den01&state=Sleeping", "", 400, nil},
+		{"by-cmts bad docsis", "GET", "/api/v1/cablemodems/by-cmts?cmts=den01&docsis=Docsis2", "", 400, nil},
+		{"by-cmts bad single", "GET", "/api/v1/cablemodems/by-cmts?cmts=den01&single=maybe", "", 400, nil},
+		{"by-poller", "GET", "/api/v1/cablemodems/by-poller?poller=RX_MER&cmts=acr01.den.example.net", "", 200,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}},
+		{"by-poller mta", "GET", "/api/v1/cablemodems/by-poller?poller=MTA_INVENTORY&cmts=den01", "", 200,
+			[]string{"a4:b1:e9:00:00:01"}},
+		{"by-poller unknown", "GET", "/api/v1/cablemodems/by-poller?poller=SNMP&cmts=den01", "", 400, nil},
+		{"by-poller missing cmts", "GET", "/api/v1/cablemodems/by-poller?poller=RX_MER", "", 400, nil},
+		{"paged", "GET", "/api/v1/cablemodems?first=2", "", 200, []string{"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02"}},
+		{"paged filter", "GET", "/api/v1/cablemodems?fiberNode=FN-102&transponder=false", "", 200,
+			[]string{"5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"paged mac", "GET", "/api/v1/cablemodems?mac=a4:b1:e9:00:00:03,5c:22:da:0e:9f:02", "", 200,
+			[]string{"5c:22:da:0e:9f:02", "a4:b1:e9:00:00:03"}},
+		{"paged mac notation", "GET", "/api/v1/cablemodems?mac=a4-b1-e9-00-00-03", "", 200, []string{"a4:b1:e9:00:00:03"}},
+		{"paged bad mac", "GET", "/api/v1/cablemodems?mac=a4b1e9", "", 400, nil},
+		{"paged bad first", "GET", "/api/v1/cablemodems?first=0", "", 400, nil},
+		{"paged bad after", "GET", "/api/v1/cablemodems?after=not-a-cursor", "", 400, nil},
+		{"paged bad docsis", "GET", "/api/v1/cablemodems?docsisVersion=Docsis2", "", 400, nil},
+		{"record reg state", "POST", "/api/v1/cablemodems/historical/reg-state",
+			`{"time": 1790000000, "devices": [{"mac": "5c:22:da:0e:9f:01", "regState": 6}]}`, 204, nil},
+		{"record reg state bad mac", "POST", "/api/v1/cablemodems/historical/reg-state",
+			`{"devices": [{"mac": "5c:22:da:0e:9f:01", "regState": 6}, {"mac": "nope", "regState": 6}]}`, 400, nil},
+		{"record reg state no devices", "POST", "/api/v1/cablemodems/historical/reg-state", `{"time": 1790000000}`, 400, nil},
+		{"record cm", "POST", "/api/v1/cablemodems/historical/cm",
+			`{"devices": [{"mac": "5c:22:da:0e:9f:01", "resets": 1}]}`, 204, nil},
+		{"record cm bad body", "POST", "/api/v1/cablemodems/historical/cm", `{"devices": `, 400, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+			if w.Code != tt.code {
+				t.Fatalf("got %d %s, want %d", w.Code, w.Body, tt.code)
+			}
+			if tt.macs == nil {
+				return
+			}
+			if got := responseMacs(t, w.Body.Bytes()); !reflect.DeepEqual(got, tt.macs) {
+				t.Errorf("got %v, want %v", got, tt.macs)
+			}
+		})
+	}
+}
+
+func TestByMacPost(t *testing.T) {
+	r := newTestRouter(t)
+	// far more macs than fit in a URL or in the bind parameters of one IN list.
+	var many strings.Builder
+	for i := 0; i < 100_000; i++ {
+		fmt.Fprintf(&many, "5c22da%06x\n", i+0x0e9f01)
+	}
+	for _, tt := range []struct {
+		name        string
+		contentType string
+		body        string
+		code        int
+		macs        []string
+	}{
+		{"json", "application/json", `["5c:22:da:0e:9f:01", "A4:B1:E9:00:00:03"]`, 200, []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"json charset", "application/json; charset=utf-8", `["5c:22:da:0e:9f:01"]`, 200, []string{"5c:22:da:0e:9f:01"}},
+		{"lines", "text/plain", "5c:22:da:0e:9f:01\r\n\n  a4b1.e900.0003  \n", 200, []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"many lines", "text/plain", many.String(), 200,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"json invalid mac", "application/json", `["5c:22:da:0e:9f:01", "nope"]`, 400, nil},
+		{"json not a list", "application/json", `{"mac": "5c:22:da:0e:9f:01"}`, 400, nil},
+		{"empty", "text/plain", "\n\n", 400, nil},
+		{"form", "application/x-www-form-urlencoded", "mac=5c:22:da:0e:9f:01", 415, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/cablemodems/by-mac", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != tt.code {
+				t.Fatalf("got %d %.200s, want %d", w.Code, w.Body, tt.code)
+			}
+			if tt.macs == nil {
+				return
+			}
+			if got := responseMacs(t, w.Body.Bytes()); !reflect.DeepEqual(got, tt.macs) {
+				t.Errorf("got %v, want %v", got, tt.macs)
+			}
+		})
+	}
+}
+
+func TestPagedFollowsCursor(t *testing.T) {
+	r := newTestRouter(t)
+	var got []string
+	target := "/api/v1/cablemodems?first=3"
+	for i := 0; i < 5; i++ {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		var page struct {
+			Edges    []cablemodems.CableModem `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &page); err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range page.Edges {
+			got = append(got, m.Mac)
+		}
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		target = "/api/v1/cablemodems?first=3&after=" + page.PageInfo.EndCursor
+	}
+	if len(got) != 7 {
+		t.Errorf("paged through %v, want all 7 modems", got)
+	}
+}
+
+// responseMacs returns the macs of a list response or of the edges of a paged one.
+func responseMacs(t *testing.T, body []byte) []string {
+	t.Helper()
+	var modems []cablemodems.CableModem
+	if err := json.Unmarshal(body, &modems); err != nil {
+		var page struct {
+			Edges []cablemodems.CableModem `json:"edges"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			t.Fatalf("%s: %v", body, err)
+		}
+		modems = page.Edges
+	}
+	macs := []string{}
+	for _, m := range modems {
+		macs = append(macs, m.Mac)
+	}
+	return macs
+}
+
+func TestInvalidMacsAreListed(t *testing.T) {
+	r := newTestRouter(t)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/cablemodems/by-mac?mac=bad1,5c:22:da:0e:9f:01,bad2", nil))
+	var body struct {
+		Invalid []string `json:"invalid"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusBadRequest || !reflect.DeepEqual(body.Invalid, []string{"bad1", "bad2"}) {
+		t.Errorf("got %d %s", w.Code, w.Body)
+	}
+}
+
+// erringStore fails every CMTS lookup with err.
+type erringStore struct {
+	cablemodems.Store
+	err error
+}
+
+func (s erringStore) ByCmts(context.Context, cablemodems.CmtsQuery) ([]*cablemodems.CableModem, error) {
+	return nil, s.err
+}
+
+func TestRepoErrorStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, tt := range []struct {
+		err  error
+		code int
+	}{
+		{cablemodems.ErrEmptyValues, http.StatusBadRequest},
+		{cablemodems.ErrMissingCmts, http.StatusBadRequest},
+		{fmt.Errorf("%w: SNMP", cablemodems.ErrUnknownPoller), http.StatusBadRequest},
+		{fmt.Errorf("%w: Daily", cablemodems.ErrInvalidPeriod), http.StatusBadRequest},
+		{cablemodems.ErrInvalidTimeRange, http.StatusBadRequest},
+		// a column outside filterableColumns is a bug of ours, not of the request.
+		{fmt.Errorf("%w: is_cpe", cablemodems.ErrUnfilterableColumn), http.StatusInternalServerError},
+		{cablemodems.ErrNotReady, http.StatusServiceUnavailable},
+		{fmt.Errorf("query: %w", context.Canceled), 499},
+		{context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{fmt.Errorf("connection reset"), http.StatusInternalServerError},
+	} {
+		r := gin.New()
+		r.Use(func(c *gin.Context) {
+			c.Set("cablemodems", cablemodems.Store(erringStore{err: tt.err}))
+			c.Next()
+		})
+		SetupRouter(r)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/cablemodems/by-cmts?cmts=den01", nil))
+		if w.Code != tt.code {
+			t.Errorf("%v: got %d %s, want %d", tt.err, w.Code, w.Body, tt.code)
+		}
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	r := newTestRouter(t)
+	for _, tt := range []struct {
+		name   string
+		method string
+		target string
+		body   string
+		code   int
+		macs   []string
+	}{
+		{"by-mac", "GET", "/api/v1/cablemodems/by-mac?mac=5c:22:da:0e:9f:01,a4:b1:e9:00:00:03", "", 200, []string{"5c:22:da:0e:9f:01", "a4:b1:e9:00:00:03"}},
+		{"by-mac post", "POST", "/api/v1/cablemodems/by-mac", `["a4:b1:e9:00:00:01"]`, 200, []string{"a4:b1:e9:00:00:01"}},
+		{"by-mac unknown", "GET", "/api/v1/cablemodems/by-mac?mac=ff:ff:ff:ff:ff:ff", "", 200, []string{}},
+		{"by-cmts", "GET", "/api/v1/cablemodems/by-cmts?cmts=acr01.den.example.net", "", 200,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"by-poller", "GET", "/api/v1/cablemodems/by-poller?poller=RX_MER&cmts=acr01.den.example.net", "", 200,
+			[]string{"5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02"}},
+		{"paged streams every page", "GET", "/api/v1/cablemodems", "", 200, []string{
+			"a4:b1:e9:00:00:01", "a4:b1:e9:00:00:02", "5c:22:da:0e:9f:01", "5c:22:da:0e:9f:02", "5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04", "a4:b1:e9:00:00:03"}},
+		{"paged first", "GET", "/api/v1/cablemodems?first=2&fiberNode=FN-102", "", 200, []string{"5c:22:da:0e:9f:03", "5c:22:da:0e:9f:04"}},
+		{"paged first caps", "GET", "/api/v1/cablemodems?first=1", "", 200, []string{"a4:b1:e9:00:00:01"}},
+		{"paged bad first", "GET", "/api/v1/cablemodems?first=0", "", 400, nil},
+		{"paged bad after", "GET", "/api/v1/cablemodems?after=not-a-cursor", "", 400, nil},
+		{"by-poller unknown", "GET", "/api/v1/cablemodems/by-poller?poller=SNMP&cmts=den01", "", 400, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Accept", "application/x-ndjson")
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != tt.code {
+				t.Fatalf("got %d %s, want %d", w.Code, w.Body, tt.code)
+			}
+			if tt.macs == nil {
+				return
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+				t.Errorf("Content-Type %q", ct)
+			}
+			got := []string{}
+			for dec := json.NewDecoder(w.Body); dec.More(); {
+				var m cablemodems.CableModem
+				if err := dec.Decode(&m); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, m.Mac)
+			}
+			if !reflect.DeepEqual(got, tt.macs) {
+				t.Errorf("got %v, want %v", got, tt.macs)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"api-project/pkg/cablemodems"
+)
+
+func TestWriteRoutes(t *testing.T) {
+	r := newTestRouter(t)
+	for _, tt := range []struct {
+		name    string
+		method  string
+		target  string
+		ifMatch string
+		body    string
+		code    int
+	}{
+		{"put creates", "PUT", "/api/v1/cablemodems/5c:22:da:0e:9f:ff", "", `{"fqdn": "acr02.den.example.net", "state": "Online"}`, 200},
+		{"put replaces", "PUT", "/api/v1/cablemodems/5c:22:da:0e:9f:ff", "", `{"mac": "5C22.DA0E.9FFF", "fqdn": "acr03.den.example.net"}`, 200},
+		{"put bad path mac", "PUT", "/api/v1/cablemodems/nope", "", `{}`, 400},
+		{"put mismatched mac", "PUT", "/api/v1/cablemodems/5c:22:da:0e:9f:ff", "", `{"mac": "5c:22:da:0e:9f:01"}`, 400},
+		{"put bad state", "PUT", "/api/v1/cablemodems/5c:22:da:0e:9f:ff", "", `{"state": "Sleeping"}`, 400},
+		{"put bad cpe mac", "PUT", "/api/v1/cablemodems/5c:22:da:0e:9f:ff", "", `{"cpeMac": "nope"}`, 400},
+		{"put stale", "PUT", "/api/v1/cablemodems/5c:22:da:0e:9f:01", `"1"`, `{}`, 412},
+		{"put bad if-match", "PUT", "/api/v1/cablemodems/5c:22:da:0e:9f:01", `W/"1760000000"`, `{}`, 400},
+		{"patch", "PATCH", "/api/v1/cablemodems/a4:b1:e9:00:00:01", `"1760000000"`, `{"cmtsType": "vcmts", "deviceType": 2}`, 200},
+		{"patch stale", "PATCH", "/api/v1/cablemodems/a4:b1:e9:00:00:01", `"1760000000"`, `{"cmtsType": "rphy"}`, 412},
+		{"patch empty", "PATCH", "/api/v1/cablemodems/a4:b1:e9:00:00:02", "", `{"updatedAtTs": 1}`, 400},
+		{"patch unknown", "PATCH", "/api/v1/cablemodems/ff:ff:ff:ff:ff:ff", "", `{"cmtsType": "vcmts"}`, 404},
+		{"delete", "DELETE", "/api/v1/cablemodems/5c:22:da:0e:9f:02", "", "", 200},
+		{"delete unknown", "DELETE", "/api/v1/cablemodems/ff:ff:ff:ff:ff:ff", "", "", 404},
+		{"delete vanished", "DELETE", "/api/v1/cablemodems/ff:ff:ff:ff:ff:ff", `"1760000000"`, "", 412},
+		{"batch", "POST", "/api/v1/cablemodems:batchUpsert", "",
+			`{"modems": [{"mac": "a4:b1:e9:00:00:03", "updatedAtTs": 1760000000, "fqdn": "den02.example.net", "ppod": "DEN02"}, {"mac": "a4-b1-e9-00-00-04"}]}`, 200},
+		{"batch stale", "POST", "/api/v1/cablemodems:batchUpsert", "",
+			`{"modems": [{"mac": "a4:b1:e9:00:00:03", "updatedAtTs": 1760000000}, {"mac": "a4:b1:e9:00:00:05"}]}`, 409},
+		{"batch duplicate", "POST", "/api/v1/cablemodems:batchUpsert", "", `{"modems": [{"mac": "a4:b1:e9:00:00:05"}, {"mac": "A4:B1:E9:00:00:05"}]}`, 400},
+		{"batch bad mac", "POST", "/api/v1/cablemodems:batchUpsert", "", `{"modems": [{"mac": "nope"}]}`, 400},
+		{"batch empty", "POST", "/api/v1/cablemodems:batchUpsert", "", `{"modems": []}`, 400},
+		{"unknown method", "POST", "/api/v1/cablemodems:batchDelete", "", `{}`, 404},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != tt.code {
+				t.Fatalf("got %d %s, want %d", w.Code, w.Body, tt.code)
+			}
+		})
+	}
+
+	// the writes above are visible to the lookups.
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/cablemodems/by-mac?mac=5c:22:da:0e:9f:ff,a4:b1:e9:00:00:01,5c:22:da:0e:9f:02,a4:b1:e9:00:00:04,a4:b1:e9:00:00:05", nil))
+	var modems []cablemodems.CableModem
+	if err := json.Unmarshal(w.Body.Bytes(), &modems); err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]cablemodems.CableModem{}
+	for _, m := range modems {
+		got[m.Mac] = m
+	}
+	if m := got["5c:22:da:0e:9f:ff"]; m.Fqdn == nil || *m.Fqdn != "acr03.den.example.net" || m.State != nil {
+		t.Errorf("put did not replace the whole record: %+v", m)
+	}
+	if m := got["a4:b1:e9:00:00:01"]; m.CmtsType == nil || *m.CmtsType != "vcmts" || m.Ppod == nil {
+		t.Errorf("patch: %+v", m)
+	}
+	if m := got["5c:22:da:0e:9f:02"]; m.NotFoundDate == nil {
+		t.Errorf("delete did not set notFoundDate: %+v", m)
+	}
+	if _, ok := got["a4:b1:e9:00:00:04"]; !ok {
+		t.Error("batch did not insert a4:b1:e9:00:00:04")
+	}
+	if _, ok := got["a4:b1:e9:00:00:05"]; ok {
+		t.Error("the conflicting batch was partly written")
+	}
+}
+
+func TestWriteRespondsWithETag(t *testing.T) {
+	r := newTestRouter(t)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPatch, "/api/v1/cablemodems/5c:22:da:0e:9f:01", strings.NewReader(`{"cmtsType": "acr"}`)))
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("got %d %s, ETag %q", w.Code, w.Body, etag)
+	}
+
+	// the ETag of one write is the precondition of the next.
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/cablemodems/5c:22:da:0e:9f:01", nil)
+	req.Header.Set("If-Match", etag)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("delete with the returned ETag: got %d %s", w.Code, w.Body)
+	}
+	// a client still holding it loses, although both writes fell within the same second.
+	req = httptest.NewRequest(http.MethodPatch, "/api/v1/cablemodems/5c:22:da:0e:9f:01", strings.NewReader(`{"cmtsType": "cbr"}`))
+	req.Header.Set("If-Match", etag)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusPreconditionFailed {
+		t.Fatalf("patch with the ETag of the write before last: got %d %s", w.Code, w.Body)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/cablemodems:batchUpsert", strings.NewReader(`{"modems": [{"mac": "5c:22:da:0e:9f:01", "updatedAtTs": 1760000000}]}`))
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var body struct {
+		Conflicts []string `json:"conflicts"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusConflict || !reflect.DeepEqual(body.Conflicts, []string{"5c:22:da:0e:9f:01"}) {
+		t.Errorf("got %d %s", w.Code, w.Body)
+	}
+}